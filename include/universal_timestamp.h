@@ -46,7 +46,8 @@ typedef enum {
     UT_ERR_UNSUPPORTED_OFFSET,    /**< Non-zero timezone offset in strict mode */
     UT_ERR_FRACTION_TOO_LONG,     /**< More than 9 fractional digits */
     UT_ERR_LEAP_SECOND,           /**< Leap second (SS=60) not supported */
-    UT_ERR_NULL_POINTER           /**< Null pointer argument */
+    UT_ERR_NULL_POINTER,          /**< Null pointer argument */
+    UT_ERR_UNSUPPORTED_CLOCK      /**< Clock source not available on this platform */
 } ut_error_t;
 
 /**
@@ -91,6 +92,20 @@ typedef enum {
     UT_PRECISION_ERROR = -1       /**< Unable to determine precision */
 } ut_precision_t;
 
+/**
+ * @brief Clock sources selectable with ut_now_source().
+ *
+ * Only UT_CLOCK_REALTIME counts from the Unix epoch. The other sources count
+ * from an unspecified starting point and are meant for measuring intervals.
+ */
+
+typedef enum {
+    UT_CLOCK_REALTIME = 0,        /**< Wall-clock time, may jump when the clock is set */
+    UT_CLOCK_MONOTONIC,           /**< Never goes backwards, may be slewed by NTP */
+    UT_CLOCK_MONOTONIC_RAW,       /**< Raw hardware clock, not adjusted by NTP */
+    UT_CLOCK_BOOTTIME             /**< Like MONOTONIC, but includes time suspended */
+} ut_clock_source_t;
+
 /**
  * @brief Timestamp structure storing nanoseconds since Unix epoch.
  *
@@ -162,6 +177,29 @@ ut_timestamp_t ut_now(void);
 
 ut_timestamp_t ut_now_monotonic(void);
 
+/**
+ * @brief Read the current time from a specific clock source.
+ *
+ * UT_CLOCK_REALTIME gives the same result as ut_now(). The monotonic
+ * sources return nanoseconds since an unspecified, platform-defined point
+ * and must only be compared with readings from the same source.
+ *
+ * @param source  Clock to read.
+ * @param out     Receives the reading on success.
+ * @return UT_OK on success, UT_ERR_NULL_POINTER if out is NULL, or
+ *         UT_ERR_UNSUPPORTED_CLOCK if the source is unavailable.
+ *
+ * @code
+ * ut_timestamp_t start, end;
+ * ut_now_source(UT_CLOCK_MONOTONIC_RAW, &start);
+ * do_work();
+ * ut_now_source(UT_CLOCK_MONOTONIC_RAW, &end);
+ * printf("took %lld ns\n", (long long)(end.nanos - start.nanos));
+ * @endcode
+ */
+
+ut_error_t ut_now_source(ut_clock_source_t source, ut_timestamp_t *out);
+
 /**
  * @brief Set a callback for clock regression events.
  *
@@ -206,6 +244,26 @@ void ut_set_regression_callback(ut_regression_callback_t callback);
 
 int ut_format(ut_timestamp_t ts, char *buf, size_t buf_size, bool include_nanos);
 
+/**
+ * @brief Get the length of a timestamp's formatted string.
+ *
+ * Returns the number of characters ut_format() would write for the same
+ * arguments, excluding the null terminator, without formatting. Use it to
+ * size buffers exactly; the buffer passed to ut_format() must still be at
+ * least UT_MAX_STRING_LEN bytes.
+ *
+ * @param ts            Timestamp to measure.
+ * @param include_nanos Same meaning as for ut_format().
+ * @return Length of the formatted string, or -1 on error.
+ *
+ * @code
+ * ut_timestamp_t ts = ut_from_unix_nanos(1734147201123456789);
+ * int len = ut_format_len(ts, true);  // 30
+ * @endcode
+ */
+
+int ut_format_len(ut_timestamp_t ts, bool include_nanos);
+
 /**
  * @brief Parse a timestamp string in strict mode.
  *
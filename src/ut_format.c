@@ -1,6 +1,6 @@
 /**
  * @file ut_format.c
- * @brief Implementation of ut_format() function.
+ * @brief Implementation of ut_format() and ut_format_len() functions.
  */
 
 
@@ -9,15 +9,12 @@
 #include <stdio.h>
 
 /**
- * @brief Format a timestamp to an ISO-8601 string.
+ * @brief Render a timestamp into buf, or only measure it when buf is NULL.
+ *
+ * Shared by ut_format() and ut_format_len() so the two cannot disagree.
  */
 
- 
-int ut_format(ut_timestamp_t ts, char *buf, size_t buf_size, bool include_nanos) {
-    if (buf == NULL || buf_size < UT_MAX_STRING_LEN) {
-        return -1;
-    }
-    
+static int format_into(ut_timestamp_t ts, char *buf, size_t buf_size, bool include_nanos) {
     int year, month, day, hour, minute, second, frac_nanos;
     ut_internal_from_nanos(ts.nanos, &year, &month, &day, 
                            &hour, &minute, &second, &frac_nanos);
@@ -48,3 +45,23 @@ int ut_format(ut_timestamp_t ts, char *buf, size_t buf_size, bool include_nanos)
     
     return len;
 }
+
+/**
+ * @brief Format a timestamp to an ISO-8601 string.
+ */
+
+int ut_format(ut_timestamp_t ts, char *buf, size_t buf_size, bool include_nanos) {
+    if (buf == NULL || buf_size < UT_MAX_STRING_LEN) {
+        return -1;
+    }
+
+    return format_into(ts, buf, buf_size, include_nanos);
+}
+
+/**
+ * @brief Get the length of a timestamp's formatted string.
+ */
+
+int ut_format_len(ut_timestamp_t ts, bool include_nanos) {
+    return format_into(ts, NULL, 0, include_nanos);
+}
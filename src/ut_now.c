@@ -1,6 +1,6 @@
 /**
  * @file ut_now.c
- * @brief Implementation of ut_now() and ut_now_monotonic() functions.
+ * @brief Implementation of ut_now(), ut_now_monotonic() and ut_now_source().
  */
 
 
@@ -136,6 +136,68 @@ ut_timestamp_t ut_now_monotonic(void) {
     return result;
 }
 
+/**
+ * @brief Read the current time from a specific clock source.
+ */
+
+ut_error_t ut_now_source(ut_clock_source_t source, ut_timestamp_t *out) {
+    if (out == NULL) {
+        return UT_ERR_NULL_POINTER;
+    }
+
+    if (source == UT_CLOCK_REALTIME) {
+        *out = ut_now();
+        return UT_OK;
+    }
+
+#if defined(UT_PLATFORM_WINDOWS)
+    if (source == UT_CLOCK_MONOTONIC) {
+        LARGE_INTEGER freq, count;
+        QueryPerformanceFrequency(&freq);
+        QueryPerformanceCounter(&count);
+        int64_t secs = count.QuadPart / freq.QuadPart;
+        int64_t rem = count.QuadPart % freq.QuadPart;
+        out->nanos = secs * 1000000000LL + rem * 1000000000LL / freq.QuadPart;
+        return UT_OK;
+    }
+    return UT_ERR_UNSUPPORTED_CLOCK;
+
+#elif defined(UT_HAS_POSIX_CLOCK)
+    clockid_t id;
+    switch (source) {
+        case UT_CLOCK_MONOTONIC:
+            id = CLOCK_MONOTONIC;
+            break;
+        case UT_CLOCK_MONOTONIC_RAW:
+    #if defined(CLOCK_MONOTONIC_RAW)
+            id = CLOCK_MONOTONIC_RAW;
+            break;
+    #else
+            return UT_ERR_UNSUPPORTED_CLOCK;
+    #endif
+        case UT_CLOCK_BOOTTIME:
+    #if defined(CLOCK_BOOTTIME)
+            id = CLOCK_BOOTTIME;
+            break;
+    #else
+            return UT_ERR_UNSUPPORTED_CLOCK;
+    #endif
+        default:
+            return UT_ERR_UNSUPPORTED_CLOCK;
+    }
+
+    struct timespec spec;
+    if (clock_gettime(id, &spec) != 0) {
+        return UT_ERR_UNSUPPORTED_CLOCK;
+    }
+    out->nanos = (int64_t)spec.tv_sec * 1000000000LL + spec.tv_nsec;
+    return UT_OK;
+
+#else
+    return UT_ERR_UNSUPPORTED_CLOCK;
+#endif
+}
+
 /**
  * @brief Set a callback for clock regression events.
  */
@@ -175,6 +237,7 @@ const char *ut_error_string(ut_error_t err) {
         case UT_ERR_FRACTION_TOO_LONG: return "Fractional seconds too long";
         case UT_ERR_LEAP_SECOND:      return "Leap second not supported";
         case UT_ERR_NULL_POINTER:     return "Null pointer";
+        case UT_ERR_UNSUPPORTED_CLOCK: return "Clock source not supported";
         default:                      return "Unknown error";
     }
 }
@@ -197,6 +197,44 @@ static void test_precision(void) {
     printf("  Detected precision: %d (0=ns, 1=us, 2=ms, 3=s)\n", prec);
 }
 
+static void test_format_len(void) {
+    printf("\n--- test_format_len ---\n");
+
+    char buf[UT_MAX_STRING_LEN];
+    ut_timestamp_t ts = ut_from_unix_nanos(1734147201123456789LL);
+    ASSERT_EQ_INT("len with nanos", ut_format_len(ts, true), 30);
+    ASSERT_EQ_INT("len without nanos", ut_format_len(ts, false), 20);
+    ASSERT_EQ_INT("len matches format", ut_format_len(ts, true), ut_format(ts, buf, sizeof(buf), true));
+
+    ut_timestamp_t half = ut_from_unix_nanos(1734147201500000000LL);
+    ASSERT_EQ_INT("len trims zeros", ut_format_len(half, true), 22);
+
+    ut_timestamp_t before = ut_from_unix_nanos(-1);
+    ASSERT_EQ_INT("len before epoch", ut_format_len(before, true), ut_format(before, buf, sizeof(buf), true));
+}
+
+static void test_clock_sources(void) {
+    printf("\n--- test_clock_sources ---\n");
+
+    ut_timestamp_t a, b;
+    ut_error_t err = ut_now_source(UT_CLOCK_REALTIME, &a);
+    ASSERT_EQ_INT("realtime ok", err, UT_OK);
+    ASSERT("realtime after 2020", a.nanos > 1577836800000000000LL);
+
+    err = ut_now_source(UT_CLOCK_MONOTONIC, &a);
+    ASSERT_EQ_INT("monotonic ok", err, UT_OK);
+    ut_now_source(UT_CLOCK_MONOTONIC, &b);
+    ASSERT("monotonic non-decreasing", b.nanos >= a.nanos);
+
+    err = ut_now_source(UT_CLOCK_MONOTONIC_RAW, &a);
+    ASSERT("monotonic raw ok or unsupported", err == UT_OK || err == UT_ERR_UNSUPPORTED_CLOCK);
+    err = ut_now_source(UT_CLOCK_BOOTTIME, &a);
+    ASSERT("boottime ok or unsupported", err == UT_OK || err == UT_ERR_UNSUPPORTED_CLOCK);
+
+    ASSERT_EQ_INT("null out", ut_now_source(UT_CLOCK_REALTIME, NULL), UT_ERR_NULL_POINTER);
+    ASSERT_EQ_INT("bad source", ut_now_source((ut_clock_source_t)99, &a), UT_ERR_UNSUPPORTED_CLOCK);
+}
+
 static void test_edge_dates(void) {
     printf("\n--- test_edge_dates ---\n");
     
@@ -333,6 +371,8 @@ int main(void) {
     test_error_strings();
     test_calendar();
     test_precision();
+    test_clock_sources();
+    test_format_len();
     test_edge_dates();
     test_nanosecond_formats();
     test_japanese_era_boundaries();
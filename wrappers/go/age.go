@@ -0,0 +1,33 @@
+package universal_timestamp
+
+import "time"
+
+// YearsBetween returns the number of whole calendar years elapsed from a to b,
+// comparing calendar dates as observed in loc. The time of day is ignored.
+// If b is before a the result is negative. A nil loc is treated as UTC.
+//
+// An anniversary of February 29 falls on March 1 in non-leap years.
+func YearsBetween(a, b Timestamp, loc *time.Location) int {
+	if b < a {
+		return -YearsBetween(b, a, loc)
+	}
+
+	from := a.In(loc)
+	to := b.In(loc)
+
+	years := to.Year() - from.Year()
+	month, day := from.Month(), from.Day()
+	if month == time.February && day == 29 && !IsLeapYear(to.Year()) {
+		month, day = time.March, 1
+	}
+	if to.Month() < month || (to.Month() == month && to.Day() < day) {
+		years--
+	}
+	return years
+}
+
+// Age returns the age in whole years at now of someone born at birth,
+// using calendar dates in loc. A nil loc is treated as UTC.
+func Age(birth, now Timestamp, loc *time.Location) int {
+	return YearsBetween(birth, now, loc)
+}
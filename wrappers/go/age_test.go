@@ -0,0 +1,45 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestYearsBetween(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"2000-06-15T00:00:00Z", "2024-06-14T23:59:59Z", 23},
+		{"2000-06-15T00:00:00Z", "2024-06-15T00:00:00Z", 24},
+		{"2000-02-29T00:00:00Z", "2023-02-28T00:00:00Z", 22},
+		{"2000-02-29T00:00:00Z", "2023-03-01T00:00:00Z", 23},
+		{"2000-02-29T00:00:00Z", "2024-02-29T00:00:00Z", 24},
+		{"2024-06-15T00:00:00Z", "2000-06-15T00:00:00Z", -24},
+	}
+
+	for _, c := range cases {
+		a, _ := Parse(c.a)
+		b, _ := Parse(c.b)
+		if got := YearsBetween(a, b, nil); got != c.expected {
+			t.Errorf("YearsBetween(%s, %s) = %d, expected %d", c.a, c.b, got, c.expected)
+		}
+	}
+}
+
+func TestAgeZone(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	birth, _ := Parse("2006-06-14T15:00:00Z")
+	now, _ := Parse("2024-06-14T15:30:00Z")
+
+	if got := Age(birth, now, loc); got != 18 {
+		t.Errorf("Age(Tokyo) = %d, expected 18", got)
+	}
+	if got := Age(birth, now-Timestamp(time.Hour), loc); got != 17 {
+		t.Errorf("Age(Tokyo) before midnight = %d, expected 17", got)
+	}
+}
@@ -0,0 +1,74 @@
+package universal_timestamp
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"math/rand"
+)
+
+// Coarsen floors ts to a multiple of granularity since the Unix epoch, so
+// Coarsen(ts, Hour) or Coarsen(ts, 24*Hour) drops the minutes or the time
+// of day before a dataset is exported. A non-positive granularity returns
+// ts unchanged.
+func Coarsen(ts Timestamp, granularity Duration) Timestamp {
+	if granularity <= 0 {
+		return ts
+	}
+	return Timestamp(floorMultiple(int64(ts), int64(granularity)))
+}
+
+// RandomJitter returns ts shifted by a uniformly random offset in
+// [-maxOffset, maxOffset], drawn from r. A nil r uses the math/rand global
+// source. Results saturate at the ends of the Timestamp range, and a
+// non-positive maxOffset returns ts unchanged. RandomJitter is for data
+// minimisation, not cryptographic secrecy.
+func RandomJitter(ts Timestamp, maxOffset Duration, r *rand.Rand) Timestamp {
+	if maxOffset <= 0 {
+		return ts
+	}
+	var n uint64
+	if r != nil {
+		n = r.Uint64()
+	} else {
+		n = rand.Uint64()
+	}
+	return shiftSaturating(ts, jitterOffset(n, maxOffset))
+}
+
+// DeterministicJitter returns ts shifted by an offset in
+// [-maxOffset, maxOffset] derived from an HMAC-SHA256 of subject under
+// secret. Every timestamp of
+// the same subject moves by the same amount, so intervals between a
+// subject's events are preserved while their absolute times are hidden,
+// and re-running an export yields identical output. Keep secret private:
+// anyone holding it can recompute and undo the offsets.
+func DeterministicJitter(ts Timestamp, maxOffset Duration, secret []byte, subject string) Timestamp {
+	if maxOffset <= 0 {
+		return ts
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(subject))
+	n := binary.BigEndian.Uint64(mac.Sum(nil))
+	return shiftSaturating(ts, jitterOffset(n, maxOffset))
+}
+
+// jitterOffset maps n to an offset in [-maxOffset, maxOffset]. The range
+// holds at most 2^64-1 values, so it is counted in uint64 without
+// overflow for every positive maxOffset.
+func jitterOffset(n uint64, maxOffset Duration) int64 {
+	m := uint64(maxOffset)
+	return int64(n%(2*m+1) - m)
+}
+
+// shiftSaturating adds offset to ts, clamping at the Timestamp range.
+func shiftSaturating(ts Timestamp, offset int64) Timestamp {
+	switch {
+	case offset > 0 && int64(ts) > math.MaxInt64-offset:
+		return math.MaxInt64
+	case offset < 0 && int64(ts) < math.MinInt64-offset:
+		return math.MinInt64
+	}
+	return ts + Timestamp(offset)
+}
@@ -0,0 +1,86 @@
+package universal_timestamp
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestCoarsen(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:34:56.789Z")
+	if got := Coarsen(ts, Hour).Format(); got != "2024-12-14T12:00:00Z" {
+		t.Errorf("Coarsen(1h) = %s", got)
+	}
+	if got := Coarsen(ts, 24*Hour).Format(); got != "2024-12-14T00:00:00Z" {
+		t.Errorf("Coarsen(24h) = %s", got)
+	}
+	if got := Coarsen(mustParse(t, "1969-12-31T23:30:00Z"), Hour).Format(); got != "1969-12-31T23:00:00Z" {
+		t.Errorf("Coarsen(pre-epoch) = %s", got)
+	}
+	if got := Coarsen(ts, 0); got != ts {
+		t.Errorf("Coarsen(0) = %s, expected unchanged", got.Format())
+	}
+	if got := Coarsen(math.MinInt64, Hour); got >= math.MinInt64+Timestamp(Hour) || got%Timestamp(Hour) != 0 {
+		t.Errorf("Coarsen(MinInt64) = %d, expected the earliest hour in range", got)
+	}
+}
+
+func TestRandomJitter(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00Z")
+	r := rand.New(rand.NewSource(1))
+	seen := make(map[Timestamp]bool)
+	for i := 0; i < 100; i++ {
+		got := RandomJitter(ts, Minute, r)
+		if got < ts-Timestamp(Minute) || got > ts+Timestamp(Minute) {
+			t.Fatalf("RandomJitter() = %s, outside ±1m", got.Format())
+		}
+		seen[got] = true
+	}
+	if len(seen) < 90 {
+		t.Errorf("RandomJitter() produced only %d distinct values", len(seen))
+	}
+
+	if got := RandomJitter(math.MaxInt64, Hour, rand.New(rand.NewSource(2))); got < math.MaxInt64-Timestamp(Hour) {
+		t.Errorf("RandomJitter(MaxInt64) = %d, expected saturation near the end of range", got)
+	}
+	if got := RandomJitter(ts, 0, nil); got != ts {
+		t.Errorf("RandomJitter(0) = %s, expected unchanged", got.Format())
+	}
+}
+
+func TestDeterministicJitter(t *testing.T) {
+	secret := []byte("export-2024")
+	a := mustParse(t, "2024-12-14T12:00:00Z")
+	b := mustParse(t, "2024-12-14T12:05:00Z")
+
+	ja := DeterministicJitter(a, Hour, secret, "user-1")
+	jb := DeterministicJitter(b, Hour, secret, "user-1")
+	if ja != DeterministicJitter(a, Hour, secret, "user-1") {
+		t.Error("DeterministicJitter() is not repeatable")
+	}
+	if jb-ja != b-a {
+		t.Errorf("interval changed from %d to %d", b-a, jb-ja)
+	}
+	if ja < a-Timestamp(Hour) || ja > a+Timestamp(Hour) {
+		t.Errorf("DeterministicJitter() = %s, outside ±1h", ja.Format())
+	}
+	if DeterministicJitter(a, Hour, secret, "user-2") == ja && DeterministicJitter(a, Hour, []byte("other"), "user-1") == ja {
+		t.Error("DeterministicJitter() ignores subject and secret")
+	}
+}
+
+func TestJitterOffsetLargeMax(t *testing.T) {
+	for _, maxOffset := range []Duration{math.MaxInt64, math.MaxInt64 - 1, math.MaxInt64/2 + 1} {
+		for _, n := range []uint64{0, 1, math.MaxInt64, 1 << 63, math.MaxUint64 - 1, math.MaxUint64} {
+			if off := jitterOffset(n, maxOffset); off < -int64(maxOffset) || off > int64(maxOffset) {
+				t.Errorf("jitterOffset(%d, %d) = %d, outside the bound", n, int64(maxOffset), off)
+			}
+		}
+		if off := jitterOffset(0, maxOffset); off != -int64(maxOffset) {
+			t.Errorf("jitterOffset(0, %d) = %d, expected %d", int64(maxOffset), off, -int64(maxOffset))
+		}
+		if off := jitterOffset(2*uint64(maxOffset), maxOffset); off != int64(maxOffset) {
+			t.Errorf("jitterOffset(2max, %d) = %d, expected %d", int64(maxOffset), off, int64(maxOffset))
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package universal_timestamp
+
+// EqualWithin reports whether t and other are at most tol apart. Readings
+// from different clocks rarely match exactly, so prefer this to == when
+// comparing them. A negative tol is treated as its absolute value.
+func (t Timestamp) EqualWithin(other Timestamp, tol Duration) bool {
+	var diff uint64
+	if t > other {
+		diff = uint64(t) - uint64(other)
+	} else {
+		diff = uint64(other) - uint64(t)
+	}
+	limit := uint64(tol)
+	if tol < 0 {
+		limit = -limit
+	}
+	return diff <= limit
+}
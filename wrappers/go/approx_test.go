@@ -0,0 +1,33 @@
+package universal_timestamp
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEqualWithin(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00Z")
+	cases := []struct {
+		other Timestamp
+		tol   Duration
+		want  bool
+	}{
+		{ts, 0, true},
+		{ts + Timestamp(Millisecond), Millisecond, true},
+		{ts - Timestamp(Millisecond), Millisecond, true},
+		{ts + Timestamp(Millisecond) + 1, Millisecond, false},
+		{ts - Timestamp(Second), -Second, true},
+	}
+	for _, c := range cases {
+		if got := ts.EqualWithin(c.other, c.tol); got != c.want {
+			t.Errorf("EqualWithin(%s, %s) = %v, expected %v", c.other.Format(), c.tol, got, c.want)
+		}
+		if got := c.other.EqualWithin(ts, c.tol); got != c.want {
+			t.Errorf("EqualWithin() is not symmetric for %s", c.other.Format())
+		}
+	}
+
+	if Timestamp(math.MinInt64).EqualWithin(math.MaxInt64, math.MaxInt64) {
+		t.Error("EqualWithin() overflowed on extreme values")
+	}
+}
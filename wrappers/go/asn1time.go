@@ -0,0 +1,177 @@
+package universal_timestamp
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// UTCTimePivot is the first two-digit year that UTCTime reads as 19YY, per
+// RFC 5280: 50-99 are 1950-1999 and 00-49 are 2000-2049.
+const UTCTimePivot = 50
+
+// FormatUTCTime formats ts as an ASN.1 UTCTime in its DER form,
+// "YYMMDDHHMMSSZ", as X.509 requires for validity dates through 2049.
+// Fractional seconds are floored. It returns ErrOutOfRange for instants
+// outside 1950-2049, which need GeneralizedTime.
+func FormatUTCTime(ts Timestamp) (string, error) {
+	t := ts.TruncateDigits(0).ToTime()
+	if t.Year() < 1900+UTCTimePivot || t.Year() >= 2000+UTCTimePivot {
+		return "", fmt.Errorf("%w: UTCTime cannot hold %s", ErrOutOfRange, ts.Format())
+	}
+	return t.Format("060102150405Z"), nil
+}
+
+// ParseUTCTime parses an ASN.1 UTCTime such as "241214120000Z". The
+// seconds may be omitted and the "Z" may be replaced by a "+hhmm" or
+// "-hhmm" offset, as BER allows. Two-digit years follow UTCTimePivot. It
+// returns ErrInvalidFormat for other input.
+func ParseUTCTime(s string) (Timestamp, error) {
+	b := []byte(s)
+	if len(b) < 2 || !isDigit(b[0]) || !isDigit(b[1]) {
+		return 0, fmt.Errorf("%w: UTCTime %q", ErrInvalidFormat, s)
+	}
+	year := 2000 + atoiDigits(b[:2])
+	if year >= 2000+UTCTimePivot {
+		year -= 100
+	}
+	f, ok := parseASN1Fields(b[2:], year, false)
+	if !ok || !f.hasMinute {
+		return 0, fmt.Errorf("%w: UTCTime %q", ErrInvalidFormat, s)
+	}
+	return f.timestamp(s)
+}
+
+// FormatGeneralizedTime formats ts as an ASN.1 GeneralizedTime in its DER
+// form, "YYYYMMDDHHMMSSZ", followed by fractional seconds without trailing
+// zeros when they are non-zero: "20241214120000.5Z".
+func FormatGeneralizedTime(ts Timestamp) string {
+	return ts.ToTime().Format("20060102150405.999999999Z")
+}
+
+// ParseGeneralizedTime parses an ASN.1 GeneralizedTime such as
+// "20241214120000Z" or "20241214120000.123Z". It also accepts the forms
+// BER and LDAP (RFC 4517) allow: omitted minutes or seconds, a fraction
+// after a "." or "," applying to the last unit given, and a "+hh", "-hh",
+// "+hhmm" or "-hhmm" offset in place of "Z". Local times without a zone
+// designator are rejected with ErrInvalidFormat, as are other malformed
+// inputs.
+func ParseGeneralizedTime(s string) (Timestamp, error) {
+	b := []byte(s)
+	if len(b) < 4 {
+		return 0, fmt.Errorf("%w: GeneralizedTime %q", ErrInvalidFormat, s)
+	}
+	for _, c := range b[:4] {
+		if !isDigit(c) {
+			return 0, fmt.Errorf("%w: GeneralizedTime %q", ErrInvalidFormat, s)
+		}
+	}
+	f, ok := parseASN1Fields(b[4:], atoiDigits(b[:4]), true)
+	if !ok {
+		return 0, fmt.Errorf("%w: GeneralizedTime %q", ErrInvalidFormat, s)
+	}
+	return f.timestamp(s)
+}
+
+// asn1Fields holds the components of a UTCTime or GeneralizedTime.
+type asn1Fields struct {
+	year, month, day, hour, minute, second int
+	hasMinute, hasSecond                   bool
+	// fraction is the fractional part of the last unit given, in
+	// billionths of that unit.
+	fraction Duration
+	offset   int
+}
+
+// parseASN1Fields parses the month onwards: MMDDHH, optional minutes and
+// seconds, an optional fraction if allowed, and a zone designator.
+func parseASN1Fields(b []byte, year int, fractions bool) (asn1Fields, bool) {
+	f := asn1Fields{year: year}
+	twoDigits := func() (int, bool) {
+		if len(b) < 2 || !isDigit(b[0]) || !isDigit(b[1]) {
+			return 0, false
+		}
+		n := atoiDigits(b[:2])
+		b = b[2:]
+		return n, true
+	}
+
+	var ok bool
+	if f.month, ok = twoDigits(); !ok {
+		return f, false
+	}
+	if f.day, ok = twoDigits(); !ok {
+		return f, false
+	}
+	if f.hour, ok = twoDigits(); !ok {
+		return f, false
+	}
+	if f.minute, f.hasMinute = twoDigits(); f.hasMinute {
+		f.second, f.hasSecond = twoDigits()
+	}
+
+	if fractions && len(b) > 0 && (b[0] == '.' || b[0] == ',') {
+		b = b[1:]
+		n, scale := 0, Duration(Second)
+		for len(b) > 0 && isDigit(b[0]) {
+			if scale > 1 {
+				scale /= 10
+				f.fraction += Duration(b[0]-'0') * scale
+			}
+			b = b[1:]
+			n++
+		}
+		if n == 0 {
+			return f, false
+		}
+	}
+
+	switch {
+	case len(b) == 1 && b[0] == 'Z':
+	case (len(b) == 3 || len(b) == 5) && (b[0] == '+' || b[0] == '-'):
+		for _, c := range b[1:] {
+			if !isDigit(c) {
+				return f, false
+			}
+		}
+		hours, minutes := atoiDigits(b[1:3]), 0
+		if len(b) == 5 {
+			minutes = atoiDigits(b[3:5])
+		}
+		if hours > 23 || minutes > 59 {
+			return f, false
+		}
+		f.offset = hours*3600 + minutes*60
+		if b[0] == '-' {
+			f.offset = -f.offset
+		}
+	default:
+		return f, false
+	}
+	return f, true
+}
+
+// timestamp validates the fields and returns the instant they describe.
+// s is the original input, for error messages.
+func (f asn1Fields) timestamp(s string) (Timestamp, error) {
+	if f.month < 1 || f.month > 12 || f.day < 1 || f.day > DaysInMonth(f.year, time.Month(f.month)) ||
+		f.hour > 23 || f.minute > 59 || f.second > 59 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+
+	// Rescale the fraction from billionths of the unit it follows to
+	// nanoseconds.
+	frac := f.fraction
+	switch {
+	case !f.hasMinute:
+		frac *= 3600
+	case !f.hasSecond:
+		frac *= 60
+	}
+
+	t := time.Date(f.year, time.Month(f.month), f.day, f.hour, f.minute, f.second, 0, time.UTC)
+	if t.Before(time.Unix(0, math.MinInt64)) || t.After(time.Unix(0, math.MaxInt64)) {
+		return 0, fmt.Errorf("%w: %q", ErrOutOfRange, s)
+	}
+	return FromTime(t) + Timestamp(frac) - Timestamp(f.offset)*Timestamp(Second), nil
+}
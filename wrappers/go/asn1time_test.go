@@ -0,0 +1,90 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUTCTime(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{"241214120000Z", "2024-12-14T12:00:00Z"},
+		{"491231235959Z", "2049-12-31T23:59:59Z"},
+		{"500101000000Z", "1950-01-01T00:00:00Z"},
+		{"2412141200Z", "2024-12-14T12:00:00Z"},
+		{"241214130000+0100", "2024-12-14T12:00:00Z"},
+	}
+	for _, c := range cases {
+		got, err := ParseUTCTime(c.in)
+		if err != nil {
+			t.Fatalf("ParseUTCTime(%q) error: %v", c.in, err)
+		}
+		if got.Format() != c.expected {
+			t.Errorf("ParseUTCTime(%q) = %s, expected %s", c.in, got.Format(), c.expected)
+		}
+	}
+
+	for _, s := range []string{"", "24121412Z", "241214120000", "241214120000.5Z", "241314120000Z", "240230120000Z"} {
+		if _, err := ParseUTCTime(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseUTCTime(%q) error = %v, expected ErrInvalidFormat", s, err)
+		}
+	}
+
+	s, err := FormatUTCTime(mustParse(t, "2024-12-14T12:00:00.9Z"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != "241214120000Z" {
+		t.Errorf("FormatUTCTime() = %s, expected 241214120000Z", s)
+	}
+	if _, err := FormatUTCTime(mustParse(t, "2050-01-01T00:00:00Z")); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("FormatUTCTime(2050) error = %v, expected ErrOutOfRange", err)
+	}
+}
+
+func TestGeneralizedTime(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{"20241214120000Z", "2024-12-14T12:00:00Z"},
+		{"20241214120000.123Z", "2024-12-14T12:00:00.123Z"},
+		{"20241214120000,5Z", "2024-12-14T12:00:00.5Z"},
+		{"20241214130000+0100", "2024-12-14T12:00:00Z"},
+		{"20241214070000-05", "2024-12-14T12:00:00Z"},
+		{"2024121412Z", "2024-12-14T12:00:00Z"},
+		{"2024121412.5Z", "2024-12-14T12:30:00Z"},
+		{"202412141230.5Z", "2024-12-14T12:30:30Z"},
+		{"19691231235959.5Z", "1969-12-31T23:59:59.5Z"},
+	}
+	for _, c := range cases {
+		got, err := ParseGeneralizedTime(c.in)
+		if err != nil {
+			t.Fatalf("ParseGeneralizedTime(%q) error: %v", c.in, err)
+		}
+		if got.Format() != c.expected {
+			t.Errorf("ParseGeneralizedTime(%q) = %s, expected %s", c.in, got.Format(), c.expected)
+		}
+	}
+
+	for _, s := range []string{"", "20241214120000", "20241214120000.Z", "2024-12-14T12:00:00Z", "20241214120000+01:00"} {
+		if _, err := ParseGeneralizedTime(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseGeneralizedTime(%q) error = %v, expected ErrInvalidFormat", s, err)
+		}
+	}
+	if _, err := ParseGeneralizedTime("99991231235959Z"); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("ParseGeneralizedTime(9999) error = %v, expected ErrOutOfRange", err)
+	}
+
+	for in, expected := range map[string]string{
+		"2024-12-14T12:00:00Z":      "20241214120000Z",
+		"2024-12-14T12:00:00.1230Z": "20241214120000.123Z",
+		"1969-12-31T23:59:59.5Z":    "19691231235959.5Z",
+	} {
+		if got := FormatGeneralizedTime(mustParse(t, in)); got != expected {
+			t.Errorf("FormatGeneralizedTime(%s) = %s, expected %s", in, got, expected)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package universal_timestamp
+
+import "sync/atomic"
+
+// AtomicTimestamp is a Timestamp that can be read and updated atomically,
+// for lock-free "last seen" tracking. The zero value holds the Unix epoch.
+// An AtomicTimestamp must not be copied after first use.
+type AtomicTimestamp struct {
+	v atomic.Int64
+}
+
+// Load returns the stored timestamp.
+func (a *AtomicTimestamp) Load() Timestamp {
+	return Timestamp(a.v.Load())
+}
+
+// Store sets the stored timestamp to ts.
+func (a *AtomicTimestamp) Store(ts Timestamp) {
+	a.v.Store(int64(ts))
+}
+
+// Swap stores ts and returns the previous timestamp.
+func (a *AtomicTimestamp) Swap(ts Timestamp) Timestamp {
+	return Timestamp(a.v.Swap(int64(ts)))
+}
+
+// CompareAndSwap stores ts if the current value is old, and reports
+// whether it did.
+func (a *AtomicTimestamp) CompareAndSwap(old, ts Timestamp) bool {
+	return a.v.CompareAndSwap(int64(old), int64(ts))
+}
+
+// StoreIfLater stores ts only if it is after the current value, and
+// reports whether it did. Concurrent callers never move the value
+// backwards.
+func (a *AtomicTimestamp) StoreIfLater(ts Timestamp) bool {
+	for {
+		cur := a.v.Load()
+		if int64(ts) <= cur {
+			return false
+		}
+		if a.v.CompareAndSwap(cur, int64(ts)) {
+			return true
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package universal_timestamp
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtomicTimestamp(t *testing.T) {
+	var a AtomicTimestamp
+	if a.Load() != 0 {
+		t.Errorf("zero value Load() = %d", int64(a.Load()))
+	}
+
+	a.Store(10)
+	if old := a.Swap(20); old != 10 || a.Load() != 20 {
+		t.Errorf("Swap() = %d, Load() = %d", int64(old), int64(a.Load()))
+	}
+	if a.StoreIfLater(15) || a.Load() != 20 {
+		t.Error("StoreIfLater() moved the value backwards")
+	}
+	if a.StoreIfLater(20) {
+		t.Error("StoreIfLater() stored an equal value")
+	}
+	if !a.StoreIfLater(30) || a.Load() != 30 {
+		t.Error("StoreIfLater() did not store a later value")
+	}
+	if a.CompareAndSwap(20, 40) || !a.CompareAndSwap(30, 40) {
+		t.Error("CompareAndSwap() results wrong")
+	}
+}
+
+func TestAtomicTimestampConcurrent(t *testing.T) {
+	var a AtomicTimestamp
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				a.StoreIfLater(Timestamp(i*8 + g))
+			}
+		}(g)
+	}
+	wg.Wait()
+	if got := a.Load(); got != 999*8+7 {
+		t.Errorf("Load() = %d, expected %d", int64(got), 999*8+7)
+	}
+}
@@ -0,0 +1,124 @@
+package universal_timestamp
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// AvroLogicalType is an Avro logical type annotating a long that holds a
+// point in time. The timestamp-* types count from the Unix epoch in UTC;
+// the local-timestamp-* types count the wall-clock reading in an
+// unspecified zone as if it were UTC.
+type AvroLogicalType int
+
+const (
+	// AvroTimestampMillis is "timestamp-millis".
+	AvroTimestampMillis AvroLogicalType = iota
+	// AvroTimestampMicros is "timestamp-micros".
+	AvroTimestampMicros
+	// AvroTimestampNanos is "timestamp-nanos".
+	AvroTimestampNanos
+	// AvroLocalTimestampMillis is "local-timestamp-millis".
+	AvroLocalTimestampMillis
+	// AvroLocalTimestampMicros is "local-timestamp-micros".
+	AvroLocalTimestampMicros
+	// AvroLocalTimestampNanos is "local-timestamp-nanos".
+	AvroLocalTimestampNanos
+)
+
+var avroLogicalTypeNames = [...]string{
+	AvroTimestampMillis:      "timestamp-millis",
+	AvroTimestampMicros:      "timestamp-micros",
+	AvroTimestampNanos:       "timestamp-nanos",
+	AvroLocalTimestampMillis: "local-timestamp-millis",
+	AvroLocalTimestampMicros: "local-timestamp-micros",
+	AvroLocalTimestampNanos:  "local-timestamp-nanos",
+}
+
+// String returns the logicalType name used in Avro schemas.
+func (lt AvroLogicalType) String() string {
+	if lt >= 0 && int(lt) < len(avroLogicalTypeNames) {
+		return avroLogicalTypeNames[lt]
+	}
+	return "AvroLogicalType(" + strconv.Itoa(int(lt)) + ")"
+}
+
+// ParseAvroLogicalType returns the logical type with the given schema name,
+// such as "timestamp-micros". It returns ErrInvalidFormat for names that
+// are not timestamp logical types.
+func ParseAvroLogicalType(name string) (AvroLogicalType, error) {
+	for lt, n := range avroLogicalTypeNames {
+		if n == name {
+			return AvroLogicalType(lt), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: unknown Avro logical type %q", ErrInvalidFormat, name)
+}
+
+// Local reports whether lt is one of the local-timestamp-* types.
+func (lt AvroLogicalType) Local() bool {
+	return lt >= AvroLocalTimestampMillis && lt <= AvroLocalTimestampNanos
+}
+
+// unit returns the duration of one tick of lt.
+func (lt AvroLogicalType) unit() (Duration, error) {
+	switch lt {
+	case AvroTimestampMillis, AvroLocalTimestampMillis:
+		return Millisecond, nil
+	case AvroTimestampMicros, AvroLocalTimestampMicros:
+		return Microsecond, nil
+	case AvroTimestampNanos, AvroLocalTimestampNanos:
+		return Nanosecond, nil
+	}
+	return 0, fmt.Errorf("%w: unknown Avro logical type %d", ErrInvalidFormat, int(lt))
+}
+
+// ToAvro converts ts to the long stored for logical type lt. Sub-unit
+// precision is floored. For local-timestamp-* types the value is the wall
+// clock in loc; loc is ignored otherwise, and a nil loc is treated as UTC.
+// It returns ErrOutOfRange if the zone offset pushes a local value past the
+// int64 range.
+func ToAvro(ts Timestamp, lt AvroLogicalType, loc *time.Location) (int64, error) {
+	unit, err := lt.unit()
+	if err != nil {
+		return 0, err
+	}
+	n := int64(ts)
+	if lt.Local() {
+		_, offset := ts.In(loc).Zone()
+		shift := int64(offset) * int64(Second)
+		if (shift > 0 && n > math.MaxInt64-shift) || (shift < 0 && n < math.MinInt64-shift) {
+			return 0, ErrOutOfRange
+		}
+		n += shift
+	}
+	return floorDiv(n, int64(unit)), nil
+}
+
+// FromAvro converts a long stored for logical type lt to a Timestamp. For
+// local-timestamp-* types the value is read as a wall-clock time in loc,
+// with a nil loc treated as UTC; wall-clock times that are skipped or
+// repeated by a transition resolve as time.Date does. It returns
+// ErrOutOfRange if the value does not fit in a Timestamp.
+func FromAvro(v int64, lt AvroLogicalType, loc *time.Location) (Timestamp, error) {
+	unit, err := lt.unit()
+	if err != nil {
+		return 0, err
+	}
+	n := v * int64(unit)
+	if n/int64(unit) != v {
+		return 0, ErrOutOfRange
+	}
+	if !lt.Local() || loc == nil || loc == time.UTC {
+		return Timestamp(n), nil
+	}
+
+	wall := time.Unix(0, n).UTC()
+	t := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), loc)
+	if t.Before(time.Unix(0, math.MinInt64)) || t.After(time.Unix(0, math.MaxInt64)) {
+		return 0, ErrOutOfRange
+	}
+	return FromTime(t), nil
+}
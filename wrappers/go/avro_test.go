@@ -0,0 +1,84 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAvroLogicalTypeNames(t *testing.T) {
+	for lt := AvroTimestampMillis; lt <= AvroLocalTimestampNanos; lt++ {
+		got, err := ParseAvroLogicalType(lt.String())
+		if err != nil || got != lt {
+			t.Errorf("ParseAvroLogicalType(%q) = %v, %v", lt.String(), got, err)
+		}
+	}
+	if _, err := ParseAvroLogicalType("date"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("ParseAvroLogicalType(date) error = %v, expected ErrInvalidFormat", err)
+	}
+	if !AvroLocalTimestampMicros.Local() || AvroTimestampMicros.Local() {
+		t.Error("Local() mismatch")
+	}
+}
+
+func TestToAvro(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00.123456789Z")
+	tests := []struct {
+		lt       AvroLogicalType
+		expected int64
+		digits   int
+	}{
+		{AvroTimestampMillis, 1734177600123, 3},
+		{AvroTimestampMicros, 1734177600123456, 6},
+		{AvroTimestampNanos, 1734177600123456789, 9},
+	}
+	for _, tt := range tests {
+		got, err := ToAvro(ts, tt.lt, nil)
+		if err != nil || got != tt.expected {
+			t.Errorf("ToAvro(%s) = %d, %v, expected %d", tt.lt, got, err, tt.expected)
+		}
+		back, err := FromAvro(got, tt.lt, nil)
+		if err != nil || back != ts.TruncateDigits(tt.digits) {
+			t.Errorf("FromAvro(%d, %s) = %s, %v", got, tt.lt, back.Format(), err)
+		}
+	}
+
+	pre := mustParse(t, "1969-12-31T23:59:59.9995Z")
+	if got, _ := ToAvro(pre, AvroTimestampMillis, nil); got != -1 {
+		t.Errorf("ToAvro(pre-epoch) = %d, expected -1 (floored)", got)
+	}
+}
+
+func TestAvroLocal(t *testing.T) {
+	loc := time.FixedZone("", -5*3600)
+	ts := mustParse(t, "2024-12-14T12:00:00Z")
+
+	got, err := ToAvro(ts, AvroLocalTimestampMillis, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wall := mustParse(t, "2024-12-14T07:00:00Z"); got != ToPrometheusMillis(wall) {
+		t.Errorf("ToAvro(local) = %d, expected wall clock 07:00", got)
+	}
+
+	back, err := FromAvro(got, AvroLocalTimestampMillis, loc)
+	if err != nil || back != ts {
+		t.Errorf("FromAvro(local) = %s, %v, expected %s", back.Format(), err, ts.Format())
+	}
+	if utc, _ := FromAvro(got, AvroLocalTimestampMillis, nil); utc != mustParse(t, "2024-12-14T07:00:00Z") {
+		t.Errorf("FromAvro(local, nil) = %s, expected wall clock as UTC", utc.Format())
+	}
+}
+
+func TestAvroOutOfRange(t *testing.T) {
+	if _, err := FromAvro(math.MaxInt64, AvroTimestampMicros, nil); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("FromAvro(MaxInt64 micros) error = %v, expected ErrOutOfRange", err)
+	}
+	if _, err := ToAvro(math.MaxInt64, AvroLocalTimestampNanos, time.FixedZone("", 3600)); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("ToAvro(MaxInt64 local) error = %v, expected ErrOutOfRange", err)
+	}
+	if _, err := ToAvro(0, AvroLogicalType(99), nil); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("ToAvro(unknown) error = %v, expected ErrInvalidFormat", err)
+	}
+}
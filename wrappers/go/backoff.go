@@ -0,0 +1,112 @@
+package universal_timestamp
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Jitter selects how a Backoff randomizes its delays.
+type Jitter int
+
+const (
+	// NoJitter uses the exact exponential delay.
+	NoJitter Jitter = iota
+	// FullJitter picks a delay uniformly from [0, exponential delay].
+	FullJitter
+	// EqualJitter keeps half of the exponential delay and randomizes the rest.
+	EqualJitter
+	// DecorrelatedJitter picks a delay uniformly from [Base, 3 * previous delay].
+	DecorrelatedJitter
+)
+
+// Backoff computes exponential retry schedules. The delay for attempt n
+// (starting at 0) is Base * 2^n, capped at Cap and randomized by Jitter.
+//
+// A Backoff is not safe for concurrent use; DecorrelatedJitter keeps the
+// previous delay between calls.
+type Backoff struct {
+	Base   Duration
+	Cap    Duration
+	Jitter Jitter
+
+	// Clock is used by Next. A nil Clock uses DefaultClock.
+	Clock Clock
+
+	// Rand returns a pseudo-random number in [0, 1). A nil Rand uses math/rand.
+	Rand func() float64
+
+	prev Duration
+}
+
+// Delay returns the wait before retry number attempt.
+func (b *Backoff) Delay(attempt int) Duration {
+	exp := b.exponential(attempt)
+
+	var d Duration
+	switch b.Jitter {
+	case FullJitter:
+		d = b.between(0, exp)
+	case EqualJitter:
+		d = exp/2 + b.between(0, exp-exp/2)
+	case DecorrelatedJitter:
+		upper := b.Base
+		if b.prev > 0 {
+			upper = b.prev * 3
+			if upper/3 != b.prev {
+				upper = math.MaxInt64
+			}
+			if b.Cap > 0 && upper > b.Cap {
+				upper = b.Cap
+			}
+		}
+		d = b.between(b.Base, upper)
+		b.prev = d
+	default:
+		d = exp
+	}
+
+	if b.Cap > 0 && d > b.Cap {
+		d = b.Cap
+	}
+	return d
+}
+
+// Next returns the timestamp at which retry number attempt should run.
+func (b *Backoff) Next(attempt int) Timestamp {
+	return clockOrSystem(b.Clock).Now() + Timestamp(b.Delay(attempt))
+}
+
+// Reset clears the state kept by DecorrelatedJitter.
+func (b *Backoff) Reset() {
+	b.prev = 0
+}
+
+// exponential returns Base * 2^attempt, saturating at Cap.
+func (b *Backoff) exponential(attempt int) Duration {
+	d := b.Base
+	for i := 0; i < attempt; i++ {
+		if b.Cap > 0 && d >= b.Cap {
+			return b.Cap
+		}
+		if d > (1<<63-1)/2 {
+			return 1<<63 - 1
+		}
+		d *= 2
+	}
+	if b.Cap > 0 && d > b.Cap {
+		return b.Cap
+	}
+	return d
+}
+
+// between returns a random duration in [lo, hi].
+func (b *Backoff) between(lo, hi Duration) Duration {
+	if hi <= lo {
+		return lo
+	}
+	r := rand.Float64
+	if b.Rand != nil {
+		r = b.Rand
+	}
+	return lo + Duration(r()*float64(hi-lo))
+}
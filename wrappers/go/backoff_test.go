@@ -0,0 +1,59 @@
+package universal_timestamp
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBackoffExponential(t *testing.T) {
+	b := &Backoff{Base: 100 * Millisecond, Cap: Second}
+
+	expected := []Duration{100 * Millisecond, 200 * Millisecond, 400 * Millisecond, 800 * Millisecond, Second, Second}
+	for attempt, want := range expected {
+		if got := b.Delay(attempt); got != want {
+			t.Errorf("Delay(%d) = %d, expected %d", attempt, got, want)
+		}
+	}
+
+	if got := b.Delay(200); got != Second {
+		t.Errorf("Delay(200) = %d, expected cap", got)
+	}
+}
+
+func TestBackoffJitter(t *testing.T) {
+	half := func() float64 { return 0.5 }
+
+	full := &Backoff{Base: Second, Cap: Minute, Jitter: FullJitter, Rand: half}
+	if got := full.Delay(2); got != 2*Second {
+		t.Errorf("FullJitter Delay(2) = %d", got)
+	}
+
+	equal := &Backoff{Base: Second, Cap: Minute, Jitter: EqualJitter, Rand: half}
+	if got := equal.Delay(2); got != 3*Second {
+		t.Errorf("EqualJitter Delay(2) = %d", got)
+	}
+
+	dec := &Backoff{Base: Second, Cap: Minute, Jitter: DecorrelatedJitter, Rand: half}
+	if got := dec.Delay(0); got != Second {
+		t.Errorf("DecorrelatedJitter first Delay = %d", got)
+	}
+	if got := dec.Delay(1); got != 2*Second {
+		t.Errorf("DecorrelatedJitter second Delay = %d", got)
+	}
+
+	// Without a Cap, a previous delay whose triple overflows is bounded by
+	// the largest Duration rather than collapsing to Base.
+	uncapped := &Backoff{Base: Second, Jitter: DecorrelatedJitter, Rand: half, prev: math.MaxInt64 / 2}
+	if got, expected := uncapped.Delay(0), Second+Duration(0.5*float64(math.MaxInt64-Second)); got != expected {
+		t.Errorf("DecorrelatedJitter Delay after overflow = %d, expected %d", got, expected)
+	}
+}
+
+func TestBackoffNext(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	b := &Backoff{Base: Second, Clock: NewManualClock(start)}
+
+	if got := b.Next(3).Format(); got != "2024-12-14T12:00:08Z" {
+		t.Errorf("Next(3) = %s", got)
+	}
+}
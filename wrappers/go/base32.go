@@ -0,0 +1,67 @@
+package universal_timestamp
+
+// crockfordAlphabet is Crockford's Base32 alphabet, which omits I, L, O and
+// U to avoid confusion and accidental words.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// base32Len is the length of a Base32 string: 64 bits in 5-bit symbols.
+const base32Len = 13
+
+// Base32 returns the timestamp as 13 characters of Crockford Base32. The
+// encoding is URL-safe, case-insensitive on decode, and sorts in
+// chronological order like ToSortableKey.
+func (t Timestamp) Base32() string {
+	var buf [base32Len]byte
+	v := uint64(t) ^ (1 << 63)
+	for i := base32Len - 1; i >= 0; i-- {
+		buf[i] = crockfordAlphabet[v&31]
+		v >>= 5
+	}
+	return string(buf[:])
+}
+
+// ParseBase32 decodes a string produced by Base32. Lower-case letters are
+// accepted, as are the Crockford substitutes O for 0 and I or L for 1.
+// Hyphens are ignored.
+func ParseBase32(s string) (Timestamp, error) {
+	var v uint64
+	n := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '-' {
+			continue
+		}
+		d := crockfordValue(c)
+		if d < 0 {
+			return 0, ErrInvalidFormat
+		}
+		if n == 0 && d > 15 {
+			return 0, ErrOutOfRange
+		}
+		v = v<<5 | uint64(d)
+		n++
+	}
+	if n != base32Len {
+		return 0, ErrInvalidFormat
+	}
+	return Timestamp(v ^ (1 << 63)), nil
+}
+
+// crockfordValue returns the value of a Base32 symbol, or -1.
+func crockfordValue(c byte) int {
+	if c >= 'a' && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	switch c {
+	case 'O':
+		return 0
+	case 'I', 'L':
+		return 1
+	}
+	for i := 0; i < len(crockfordAlphabet); i++ {
+		if crockfordAlphabet[i] == c {
+			return i
+		}
+	}
+	return -1
+}
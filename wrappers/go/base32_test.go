@@ -0,0 +1,63 @@
+package universal_timestamp
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBase32RoundTrip(t *testing.T) {
+	ordered := []Timestamp{math.MinInt64, -1, 0, 1, mustParse(t, "2024-12-14T12:00:00.123456789Z"), math.MaxInt64}
+	for i, ts := range ordered {
+		s := ts.Base32()
+		if len(s) != 13 {
+			t.Errorf("Base32(%d) = %q, expected 13 characters", int64(ts), s)
+		}
+		back, err := ParseBase32(s)
+		if err != nil || back != ts {
+			t.Errorf("ParseBase32(%q) = %d, %v; expected %d", s, int64(back), err, int64(ts))
+		}
+		if i > 0 && ordered[i-1].Base32() >= s {
+			t.Errorf("Base32 of %d does not sort before %d", int64(ordered[i-1]), int64(ts))
+		}
+	}
+
+	if s := Timestamp(0).Base32(); s != "8000000000000" {
+		t.Errorf("Base32(0) = %s", s)
+	}
+}
+
+func TestParseBase32Lenient(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00Z")
+	s := ts.Base32()
+
+	lower := []byte(s)
+	for i, c := range lower {
+		if c >= 'A' && c <= 'Z' {
+			lower[i] = c + 'a' - 'A'
+		}
+	}
+	if got, err := ParseBase32(string(lower)); err != nil || got != ts {
+		t.Errorf("ParseBase32(lower case) = %d, %v", int64(got), err)
+	}
+	if got, err := ParseBase32("8OOO-OOOO-OOOOO"); err != nil || got != 0 {
+		t.Errorf("ParseBase32 with O and hyphens = %d, %v", int64(got), err)
+	}
+	if got, err := ParseBase32("800000000000l"); err != nil || got != 1 {
+		t.Errorf("ParseBase32 with l = %d, %v", int64(got), err)
+	}
+}
+
+func TestParseBase32Errors(t *testing.T) {
+	cases := map[string]error{
+		"":               ErrInvalidFormat,
+		"800000000000":   ErrInvalidFormat,
+		"80000000000000": ErrInvalidFormat,
+		"800000000000U":  ErrInvalidFormat,
+		"G000000000000":  ErrOutOfRange,
+	}
+	for input, expected := range cases {
+		if _, err := ParseBase32(input); err != expected {
+			t.Errorf("ParseBase32(%q) = %v, expected %v", input, err, expected)
+		}
+	}
+}
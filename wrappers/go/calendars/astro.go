@@ -0,0 +1,350 @@
+package calendars
+
+import "math"
+
+// The astronomical routines below follow Reingold and Dershowitz,
+// "Calendrical Calculations", and Meeus, "Astronomical Algorithms". Times
+// are moments: fixed day numbers (R.D., day 1 is 0001-01-01 Gregorian)
+// with a fractional part, in Universal Time.
+
+const (
+	meanSynodicMonth = 29.530588861
+	meanTropicalYear = 365.242189
+
+	// j2000 is the moment of 2000-01-01T12:00 TT.
+	j2000 = 730120.5
+	// jdOffset converts a moment to a Julian day number.
+	jdOffset = 1721424.5
+	// newMoonEpoch is the moment of the first new moon of 2000, Meeus'
+	// lunation 0.
+	newMoonEpoch = 730125.76
+)
+
+func sinDeg(x float64) float64 { return math.Sin(x * math.Pi / 180) }
+func cosDeg(x float64) float64 { return math.Cos(x * math.Pi / 180) }
+
+// mod360 reduces x to [0, 360).
+func mod360(x float64) float64 {
+	x = math.Mod(x, 360)
+	if x < 0 {
+		x += 360
+	}
+	return x
+}
+
+// deltaT returns TT-UT in days at moment t, using the polynomial
+// approximations of Espenak and Meeus.
+func deltaT(t float64) float64 {
+	y := 2000 + (t-j2000)/365.2425
+	var s float64
+	switch {
+	case y < 1700:
+		u := y - 1600
+		s = 120 - 0.9808*u - 0.01532*u*u + u*u*u/7129
+	case y < 1800:
+		u := y - 1700
+		s = 8.83 + 0.1603*u - 0.0059285*u*u + 0.00013336*u*u*u - u*u*u*u/1174000
+	case y < 1860:
+		u := y - 1800
+		s = 13.72 - 0.332447*u + 0.0068612*u*u + 0.0041116*u*u*u - 0.00037436*math.Pow(u, 4) +
+			0.0000121272*math.Pow(u, 5) - 0.0000001699*math.Pow(u, 6) + 0.000000000875*math.Pow(u, 7)
+	case y < 1900:
+		u := y - 1860
+		s = 7.62 + 0.5737*u - 0.251754*u*u + 0.01680668*u*u*u - 0.0004473624*math.Pow(u, 4) + math.Pow(u, 5)/233174
+	case y < 1920:
+		u := y - 1900
+		s = -2.79 + 1.494119*u - 0.0598939*u*u + 0.0061966*u*u*u - 0.000197*math.Pow(u, 4)
+	case y < 1941:
+		u := y - 1920
+		s = 21.20 + 0.84493*u - 0.076100*u*u + 0.0020936*u*u*u
+	case y < 1961:
+		u := y - 1950
+		s = 29.07 + 0.407*u - u*u/233 + u*u*u/2547
+	case y < 1986:
+		u := y - 1975
+		s = 45.45 + 1.067*u - u*u/260 - u*u*u/718
+	case y < 2005:
+		u := y - 2000
+		s = 63.86 + 0.3345*u - 0.060374*u*u + 0.0017275*u*u*u + 0.000651814*math.Pow(u, 4) + 0.00002373599*math.Pow(u, 5)
+	case y < 2050:
+		u := y - 2000
+		s = 62.92 + 0.32217*u + 0.005589*u*u
+	case y < 2150:
+		u := (y - 1820) / 100
+		s = -20 + 32*u*u - 0.5628*(2150-y)
+	default:
+		u := (y - 1820) / 100
+		s = -20 + 32*u*u
+	}
+	return s / 86400
+}
+
+// julianCenturies returns Julian centuries of dynamical time since J2000.
+func julianCenturies(t float64) float64 {
+	return (t + deltaT(t) - j2000) / 36525
+}
+
+// solarLongitudeTerms are the periodic terms of the solar longitude series
+// in Calendrical Calculations: coefficient, addend and multiplier.
+var solarLongitudeTerms = [...][3]float64{
+	{403406, 270.54861, 0.9287892},
+	{195207, 340.19128, 35999.1376958},
+	{119433, 63.91854, 35999.4089666},
+	{112392, 331.26220, 35998.7287385},
+	{3891, 317.843, 71998.20261},
+	{2819, 86.631, 71998.4403},
+	{1721, 240.052, 36000.35726},
+	{660, 310.26, 71997.4812},
+	{350, 247.23, 32964.4678},
+	{334, 260.87, -19.4410},
+	{314, 297.82, 445267.1117},
+	{268, 343.14, 45036.8840},
+	{242, 166.79, 3.1008},
+	{234, 81.53, 22518.4434},
+	{158, 3.50, -19.9739},
+	{132, 132.75, 65928.9345},
+	{129, 182.95, 9038.0293},
+	{114, 162.03, 3034.7684},
+	{99, 29.8, 33718.148},
+	{93, 266.4, 3034.448},
+	{86, 249.2, -2280.773},
+	{78, 157.6, 29929.992},
+	{72, 257.8, 31556.493},
+	{68, 185.1, 149.588},
+	{64, 69.9, 9037.750},
+	{46, 8.0, 107997.405},
+	{38, 197.1, -4444.176},
+	{37, 250.4, 151.771},
+	{32, 65.3, 67555.316},
+	{29, 162.7, 31556.080},
+	{28, 341.5, -4561.540},
+	{27, 291.6, 107996.706},
+	{27, 98.5, 1221.655},
+	{25, 146.7, 62894.167},
+	{24, 110.0, 31437.369},
+	{21, 5.2, 14578.298},
+	{21, 342.6, -31931.757},
+	{20, 230.9, 34777.243},
+	{18, 256.1, 1221.999},
+	{17, 45.3, 62894.511},
+	{14, 242.9, -4442.039},
+	{13, 115.2, 107997.909},
+	{13, 151.8, 119.066},
+	{13, 285.3, 16859.071},
+	{12, 53.3, -4.578},
+	{10, 126.6, 26895.292},
+	{10, 205.7, -39.127},
+	{10, 85.9, 12297.536},
+	{10, 146.1, 90073.778},
+}
+
+// solarLongitude returns the Sun's apparent ecliptic longitude in degrees
+// at moment t.
+func solarLongitude(t float64) float64 {
+	c := julianCenturies(t)
+	var sum float64
+	for _, term := range solarLongitudeTerms {
+		sum += term[0] * sinDeg(term[1]+term[2]*c)
+	}
+	lambda := 282.7771834 + 36000.76953744*c + 0.000005729577951308232*sum
+	aberration := 0.0000974*cosDeg(177.63+35999.01848*c) - 0.005575
+	a := 124.90 - 1934.134*c + 0.002063*c*c
+	b := 201.11 + 72001.5377*c + 0.00057*c*c
+	nutation := -0.004778*sinDeg(a) - 0.0003667*sinDeg(b)
+	return mod360(lambda + aberration + nutation)
+}
+
+// solarLongitudeAfter returns the first moment at or after t when the
+// Sun's longitude reaches lambda degrees.
+func solarLongitudeAfter(lambda, t float64) float64 {
+	rate := meanTropicalYear / 360
+	tau := t + rate*mod360(lambda-solarLongitude(t))
+	lo, hi := math.Max(t, tau-5), tau+5
+	for hi-lo > 1e-6 {
+		mid := (lo + hi) / 2
+		if mod360(solarLongitude(mid)-lambda) < 180 {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// estimatePriorSolarLongitude approximates the last moment before t when
+// the Sun's longitude was lambda degrees.
+func estimatePriorSolarLongitude(lambda, t float64) float64 {
+	rate := meanTropicalYear / 360
+	tau := t - rate*mod360(solarLongitude(t)-lambda)
+	delta := mod360(solarLongitude(tau)-lambda+180) - 180
+	return math.Min(t, tau-rate*delta)
+}
+
+// newMoonTerms are Meeus' periodic terms for the true new moon:
+// coefficient, power of E, and multiples of M, M' and F.
+var newMoonTerms = [...]struct {
+	coef        float64
+	e, m, mp, f float64
+}{
+	{-0.40720, 0, 0, 1, 0},
+	{0.17241, 1, 1, 0, 0},
+	{0.01608, 0, 0, 2, 0},
+	{0.01039, 0, 0, 0, 2},
+	{0.00739, 1, -1, 1, 0},
+	{-0.00514, 1, 1, 1, 0},
+	{0.00208, 2, 2, 0, 0},
+	{-0.00111, 0, 0, 1, -2},
+	{-0.00057, 0, 0, 1, 2},
+	{0.00056, 1, 1, 2, 0},
+	{-0.00042, 0, 0, 3, 0},
+	{0.00042, 1, 1, 0, 2},
+	{0.00038, 1, 1, 0, -2},
+	{-0.00024, 1, -1, 2, 0},
+	{-0.00007, 0, 2, 1, 0},
+	{0.00004, 0, 0, 2, -2},
+	{0.00004, 0, 3, 0, 0},
+	{0.00003, 0, 1, 1, -2},
+	{0.00003, 0, 0, 2, 2},
+	{-0.00003, 0, 1, 1, 2},
+	{0.00003, 0, -1, 1, 2},
+	{-0.00002, 0, -1, 1, -2},
+	{-0.00002, 0, 1, 3, 0},
+	{0.00002, 0, 0, 4, 0},
+}
+
+// newMoonPlanetary are Meeus' planetary arguments for the true new moon:
+// coefficient, constant and rate per lunation.
+var newMoonPlanetary = [...][3]float64{
+	{0.000325, 299.77, 0.107408},
+	{0.000165, 251.88, 0.016321},
+	{0.000164, 251.83, 26.651886},
+	{0.000126, 349.42, 36.412478},
+	{0.000110, 84.66, 18.206239},
+	{0.000062, 141.74, 53.303771},
+	{0.000060, 207.14, 2.453732},
+	{0.000056, 154.84, 7.306860},
+	{0.000047, 34.52, 27.261239},
+	{0.000042, 207.19, 0.121824},
+	{0.000040, 291.34, 1.844379},
+	{0.000037, 161.72, 24.198154},
+	{0.000035, 239.56, 25.513099},
+	{0.000023, 331.55, 3.592518},
+}
+
+// newMoon returns the moment of Meeus' lunation k, counted from the first
+// new moon of 2000.
+func newMoon(k int) float64 {
+	kf := float64(k)
+	c := kf / 1236.85
+	c2, c3, c4 := c*c, c*c*c, c*c*c*c
+	jde := 2451550.09766 + 29.530588861*kf + 0.00015437*c2 - 0.000000150*c3 + 0.00000000073*c4
+
+	e := 1 - 0.002516*c - 0.0000074*c2
+	m := 2.5534 + 29.10535670*kf - 0.0000014*c2 - 0.00000011*c3
+	mp := 201.5643 + 385.81693528*kf + 0.0107582*c2 + 0.00001238*c3 - 0.000000058*c4
+	f := 160.7108 + 390.67050284*kf - 0.0016118*c2 - 0.00000227*c3 + 0.000000011*c4
+	omega := 124.7746 - 1.56375588*kf + 0.0020672*c2 + 0.00000215*c3
+
+	for _, term := range newMoonTerms {
+		jde += term.coef * math.Pow(e, term.e) * sinDeg(term.m*m+term.mp*mp+term.f*f)
+	}
+	jde -= 0.00017 * sinDeg(omega)
+
+	a1 := 299.77 + 0.107408*kf - 0.009173*c2
+	jde += newMoonPlanetary[0][0] * sinDeg(a1)
+	for _, term := range newMoonPlanetary[1:] {
+		jde += term[0] * sinDeg(term[1]+term[2]*kf)
+	}
+
+	t := jde - jdOffset
+	return t - deltaT(t)
+}
+
+// lunationBefore returns the number of the last new moon before t.
+func lunationBefore(t float64) int {
+	k := int(math.Floor((t-newMoonEpoch)/meanSynodicMonth)) + 1
+	for newMoon(k) >= t {
+		k--
+	}
+	for newMoon(k+1) < t {
+		k++
+	}
+	return k
+}
+
+// newMoonBefore returns the moment of the last new moon before t.
+func newMoonBefore(t float64) float64 {
+	return newMoon(lunationBefore(t))
+}
+
+// newMoonAtOrAfter returns the moment of the first new moon at or after t.
+func newMoonAtOrAfter(t float64) float64 {
+	return newMoon(lunationBefore(t) + 1)
+}
+
+// lunarPosition returns the Moon's geocentric ecliptic longitude and
+// latitude and its horizontal parallax, in degrees, at moment t. It uses
+// the low-precision series of the Astronomical Almanac, good to about a
+// third of a degree.
+func lunarPosition(t float64) (lon, lat, parallax float64) {
+	c := julianCenturies(t)
+	lon = 218.32 + 481267.881*c +
+		6.29*sinDeg(135.0+477198.87*c) -
+		1.27*sinDeg(259.3-413335.36*c) +
+		0.66*sinDeg(235.7+890534.22*c) +
+		0.21*sinDeg(269.9+954397.74*c) -
+		0.19*sinDeg(357.5+35999.05*c) -
+		0.11*sinDeg(186.5+966404.03*c)
+	lat = 5.13*sinDeg(93.3+483202.02*c) +
+		0.28*sinDeg(228.2+960400.89*c) -
+		0.28*sinDeg(318.3+6003.15*c) -
+		0.17*sinDeg(217.6-407332.21*c)
+	parallax = 0.9508 +
+		0.0518*cosDeg(135.0+477198.87*c) +
+		0.0095*cosDeg(259.3-413335.36*c) +
+		0.0078*cosDeg(235.7+890534.22*c) +
+		0.0028*cosDeg(269.9+954397.74*c)
+	return mod360(lon), lat, parallax
+}
+
+// altitude returns the geocentric altitude in degrees, at moment t, of a
+// body at ecliptic longitude lon and latitude lat, seen from latitude phi
+// and east longitude psi.
+func altitude(t, lon, lat, phi, psi float64) float64 {
+	c := julianCenturies(t)
+	eps := 23.439291 - 0.0130042*c
+	ra := math.Atan2(sinDeg(lon)*cosDeg(eps)-math.Tan(lat*math.Pi/180)*sinDeg(eps), cosDeg(lon)) * 180 / math.Pi
+	dec := math.Asin(sinDeg(lat)*cosDeg(eps)+cosDeg(lat)*sinDeg(eps)*sinDeg(lon)) * 180 / math.Pi
+
+	sidereal := 280.46061837 + 360.98564736629*(t+jdOffset-2451545.0)
+	hourAngle := sidereal + psi - ra
+	return math.Asin(sinDeg(phi)*sinDeg(dec)+cosDeg(phi)*cosDeg(dec)*cosDeg(hourAngle)) * 180 / math.Pi
+}
+
+// sunAltitudeAtSet is the geocentric altitude of the Sun's centre at
+// sunset, allowing for refraction and the solar semi-diameter.
+const sunAltitudeAtSet = -0.8333
+
+// sunset returns the moment of sunset on the local afternoon that starts
+// at moment noon, seen from latitude phi and east longitude psi. It
+// searches the 11 hours after noon.
+func sunset(noon, phi, psi float64) float64 {
+	lo, hi := noon, noon+11.0/24
+	for hi-lo > 1e-6 {
+		mid := (lo + hi) / 2
+		if altitude(mid, solarLongitude(mid), 0, phi, psi) > sunAltitudeAtSet {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// moonAboveHorizon reports whether the Moon's upper limb is still above
+// the horizon at moment t, allowing for refraction and parallax, seen
+// from latitude phi and east longitude psi.
+func moonAboveHorizon(t, phi, psi float64) bool {
+	lon, lat, parallax := lunarPosition(t)
+	return altitude(t, lon, lat, phi, psi) > 0.7275*parallax-0.5667
+}
@@ -0,0 +1,56 @@
+package calendars
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/mozrin/universal_timestamp/civil"
+)
+
+// moment returns the moment of a UTC date and time.
+func moment(year int, month time.Month, day, hour, minute int) float64 {
+	return float64(fixedFromCivil(civil.Date{Year: year, Month: month, Day: day})) + (float64(hour)+float64(minute)/60)/24
+}
+
+func TestNewMoon(t *testing.T) {
+	expected := []float64{
+		moment(2024, time.January, 11, 11, 57),
+		moment(2024, time.April, 8, 18, 21),
+		moment(2017, time.August, 21, 18, 30),
+		moment(1999, time.August, 11, 11, 8),
+	}
+	for _, want := range expected {
+		got := newMoonAtOrAfter(want - 1)
+		if math.Abs(got-want)*24*60 > 3 {
+			t.Errorf("new moon near %s = %.4f, expected %.4f", civilFromFixed(floor(want)), got, want)
+		}
+	}
+}
+
+func TestSolarLongitude(t *testing.T) {
+	expected := []struct {
+		lambda float64
+		at     float64
+	}{
+		{0, moment(2024, time.March, 20, 3, 6)},
+		{90, moment(2024, time.June, 20, 20, 51)},
+		{180, moment(2024, time.September, 22, 12, 44)},
+		{270, moment(2024, time.December, 21, 9, 21)},
+	}
+	for _, tt := range expected {
+		got := solarLongitudeAfter(tt.lambda, tt.at-10)
+		if math.Abs(got-tt.at)*24*60 > 3 {
+			t.Errorf("solar longitude %v reached at %.4f, expected %.4f", tt.lambda, got, tt.at)
+		}
+	}
+}
+
+func TestSunset(t *testing.T) {
+	// Sunset in Mecca on 2024-03-10 was at 18:29 local time.
+	noon := moment(2024, time.March, 10, 9, 0)
+	want := moment(2024, time.March, 10, 15, 29)
+	if got := sunset(noon, meccaLatitude, meccaLongitude); math.Abs(got-want)*24*60 > 2 {
+		t.Errorf("sunset = %.4f, expected %.4f", got, want)
+	}
+}
@@ -0,0 +1,88 @@
+// Package calendars converts between timestamps and dates in the Hebrew,
+// Islamic (Umm al-Qura) and Chinese lunisolar calendars, for display in
+// internationalized products. Like civil.Date, the dates are not instants:
+// a timestamp is first read as a Gregorian date in a location, and that
+// date is converted. Days run from midnight to midnight, even in calendars
+// whose religious day begins at sunset.
+//
+// The Hebrew calendar is arithmetic and exact. The Umm al-Qura and Chinese
+// calendars are defined astronomically, by new moons, sunsets and solar
+// terms; they are computed here with published series accurate to a few
+// minutes, so a date whose defining event falls within minutes of the
+// deciding boundary can differ by a day from the official tables.
+package calendars
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+	"github.com/mozrin/universal_timestamp/civil"
+)
+
+// ErrInvalid is returned when a date does not exist in its calendar.
+var ErrInvalid = errors.New("invalid calendar date")
+
+// unixEpochFixed is the fixed day number of 1970-01-01.
+const unixEpochFixed = 719163
+
+// fixedFromCivil returns the fixed day number of d, counting 0001-01-01 of
+// the proleptic Gregorian calendar as day 1.
+func fixedFromCivil(d civil.Date) int {
+	secs := time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC).Unix()
+	return int(floorDiv(secs, 86400)) + unixEpochFixed
+}
+
+// civilFromFixed returns the Gregorian date of fixed day number n.
+func civilFromFixed(n int) civil.Date {
+	y, m, d := time.Unix(int64(n-unixEpochFixed)*86400, 0).UTC().Date()
+	return civil.Date{Year: y, Month: m, Day: d}
+}
+
+// fixedOf returns the fixed day number of the date of ts in loc.
+func fixedOf(ts uts.Timestamp, loc *time.Location) int {
+	return fixedFromCivil(civil.DateOf(ts, loc))
+}
+
+// gregorianYear returns the Gregorian year containing fixed day n.
+func gregorianYear(n int) int {
+	return civilFromFixed(n).Year
+}
+
+// floorDiv divides a by b, rounding towards negative infinity.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// quotient returns a/b rounded towards negative infinity.
+func quotient(a, b int) int {
+	return int(floorDiv(int64(a), int64(b)))
+}
+
+// floor returns the largest integer not above x.
+func floor(x float64) int {
+	return int(math.Floor(x))
+}
+
+// mod returns x mod y in the range 0..y-1.
+func mod(x, y int) int {
+	m := x % y
+	if m < 0 {
+		m += y
+	}
+	return m
+}
+
+// amod returns x mod y in the range 1..y.
+func amod(x, y int) int {
+	m := x % y
+	if m <= 0 {
+		m += y
+	}
+	return m
+}
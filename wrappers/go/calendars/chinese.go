@@ -0,0 +1,228 @@
+package calendars
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+	"github.com/mozrin/universal_timestamp/civil"
+)
+
+// ChineseDate is a date in the Chinese lunisolar calendar as computed by
+// the Purple Mountain Observatory rules: months begin on the day of the new
+// moon in Beijing time, the eleventh month contains the winter solstice,
+// and in a year of thirteen months the first month without a major solar
+// term is a leap month repeating the previous month's number.
+type ChineseDate struct {
+	// Year is the Gregorian year in which the Chinese year begins, so the
+	// year of the Dragon that began on 2024-02-10 is 2024.
+	Year int
+	// Month is the month number, 1-12.
+	Month int
+	// Leap reports whether the month is the leap month following Month.
+	Leap bool
+	// Day is the day of the month, 1-30.
+	Day int
+}
+
+var (
+	heavenlyStems   = [...]string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+	earthlyBranches = [...]string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+)
+
+// chineseEpoch is the fixed day number of the start of the first year of
+// the traditional sexagenary count, 2637 BCE.
+const chineseEpoch = -963099
+
+// ChineseOf returns the Chinese date of ts as observed in loc. A nil loc
+// is treated as UTC; pass Asia/Shanghai for the date as seen in China.
+func ChineseOf(ts uts.Timestamp, loc *time.Location) ChineseDate {
+	return chineseFromFixed(fixedOf(ts, loc))
+}
+
+// ChineseFromCivil returns the Chinese date of the Gregorian date d.
+func ChineseFromCivil(d civil.Date) ChineseDate {
+	return chineseFromFixed(fixedFromCivil(d))
+}
+
+// CycleYear returns the position, 1-60, of the date's year in the
+// sexagenary cycle; 2024 is 41.
+func (d ChineseDate) CycleYear() int {
+	return amod(d.Year-3, 60)
+}
+
+// YearName returns the stem-branch name of the date's year, such as
+// "甲辰" for 2024.
+func (d ChineseDate) YearName() string {
+	n := d.CycleYear() - 1
+	return heavenlyStems[n%10] + earthlyBranches[n%12]
+}
+
+// IsValid reports whether the date exists in the calendar.
+func (d ChineseDate) IsValid() bool {
+	if d.Month < 1 || d.Month > 12 || d.Day < 1 || d.Day > 30 {
+		return false
+	}
+	return chineseFromFixed(fixedFromChinese(d)) == d
+}
+
+// Civil returns the Gregorian date of d. It returns ErrInvalid if d does
+// not exist, for example a leap month in a year without one.
+func (d ChineseDate) Civil() (civil.Date, error) {
+	if !d.IsValid() {
+		return civil.Date{}, fmt.Errorf("%w: %s", ErrInvalid, d)
+	}
+	return civilFromFixed(fixedFromChinese(d)), nil
+}
+
+// Start returns the first instant of d in loc. A nil loc is treated as
+// UTC.
+func (d ChineseDate) Start(loc *time.Location) (uts.Timestamp, error) {
+	c, err := d.Civil()
+	if err != nil {
+		return 0, err
+	}
+	return c.Start(loc), nil
+}
+
+// String formats the date as "2024-01-01", with an "L" after the month
+// number of a leap month: "2023-02L-01".
+func (d ChineseDate) String() string {
+	leap := ""
+	if d.Leap {
+		leap = "L"
+	}
+	return fmt.Sprintf("%04d-%02d%s-%02d", d.Year, d.Month, leap, d.Day)
+}
+
+// chineseZone returns the UTC offset in days of Beijing on fixed day n:
+// local mean time before 1929, China Standard Time afterwards.
+func chineseZone(n float64) float64 {
+	if gregorianYear(floor(n)) < 1929 {
+		return 1397.0 / 180 / 24
+	}
+	return 8.0 / 24
+}
+
+// midnightInChina returns the moment at which fixed day n begins in
+// Beijing.
+func midnightInChina(n int) float64 {
+	return float64(n) - chineseZone(float64(n))
+}
+
+// chineseDay returns the fixed day number in Beijing of moment t.
+func chineseDay(t float64) int {
+	return floor(t + chineseZone(t))
+}
+
+// chineseWinterSolstice returns the fixed day number, in Beijing, of the
+// winter solstice on or before fixed day n.
+func chineseWinterSolstice(n int) int {
+	approx := estimatePriorSolarLongitude(270, midnightInChina(n+1))
+	day := floor(approx) - 1
+	for solarLongitude(midnightInChina(day+1)) <= 270 {
+		day++
+	}
+	return day
+}
+
+// chineseNewMoonOnOrAfter returns the fixed day number, in Beijing, of
+// the first new moon on or after fixed day n.
+func chineseNewMoonOnOrAfter(n int) int {
+	return chineseDay(newMoonAtOrAfter(midnightInChina(n)))
+}
+
+// chineseNewMoonBefore returns the fixed day number, in Beijing, of the
+// last new moon before fixed day n.
+func chineseNewMoonBefore(n int) int {
+	return chineseDay(newMoonBefore(midnightInChina(n)))
+}
+
+// currentMajorSolarTerm returns the index, 1-12, of the last major solar
+// term before the start of fixed day n in Beijing.
+func currentMajorSolarTerm(n int) int {
+	s := solarLongitude(midnightInChina(n))
+	return amod(2+floor(s/30), 12)
+}
+
+// noMajorSolarTerm reports whether the month beginning on fixed day n
+// contains no major solar term.
+func noMajorSolarTerm(n int) bool {
+	return currentMajorSolarTerm(n) == currentMajorSolarTerm(chineseNewMoonOnOrAfter(n+1))
+}
+
+// priorLeapMonth reports whether a leap month occurs between the months
+// beginning on fixed days first and n inclusive.
+func priorLeapMonth(first, n int) bool {
+	for n >= first {
+		if noMajorSolarTerm(n) {
+			return true
+		}
+		n = chineseNewMoonBefore(n)
+	}
+	return false
+}
+
+// chineseNewYearInSui returns the fixed day number of the Chinese new year
+// in the solstice-to-solstice year containing fixed day n.
+func chineseNewYearInSui(n int) int {
+	s1 := chineseWinterSolstice(n)
+	s2 := chineseWinterSolstice(s1 + 370)
+	m12 := chineseNewMoonOnOrAfter(s1 + 1)
+	m13 := chineseNewMoonOnOrAfter(m12 + 1)
+	nextM11 := chineseNewMoonBefore(s2 + 1)
+	if math.Round(float64(nextM11-m12)/meanSynodicMonth) == 12 && (noMajorSolarTerm(m12) || noMajorSolarTerm(m13)) {
+		return chineseNewMoonOnOrAfter(m13 + 1)
+	}
+	return m13
+}
+
+// chineseNewYearOnOrBefore returns the fixed day number of the Chinese new
+// year on or before fixed day n.
+func chineseNewYearOnOrBefore(n int) int {
+	if newYear := chineseNewYearInSui(n); n >= newYear {
+		return newYear
+	}
+	return chineseNewYearInSui(n - 180)
+}
+
+// chineseFromFixed returns the Chinese date of fixed day number n.
+func chineseFromFixed(n int) ChineseDate {
+	s1 := chineseWinterSolstice(n)
+	s2 := chineseWinterSolstice(s1 + 370)
+	m12 := chineseNewMoonOnOrAfter(s1 + 1)
+	nextM11 := chineseNewMoonBefore(s2 + 1)
+	m := chineseNewMoonBefore(n + 1)
+	leapYear := math.Round(float64(nextM11-m12)/meanSynodicMonth) == 12
+
+	months := int(math.Round(float64(m-m12) / meanSynodicMonth))
+	if leapYear && priorLeapMonth(m12, m) {
+		months--
+	}
+	month := amod(months, 12)
+	leap := leapYear && noMajorSolarTerm(m) && !priorLeapMonth(m12, chineseNewMoonBefore(m))
+	elapsed := floor(1.5 - float64(month)/12 + float64(n-chineseEpoch)/meanTropicalYear)
+
+	return ChineseDate{
+		Year:  elapsed - 2637,
+		Month: month,
+		Leap:  leap,
+		Day:   n - m + 1,
+	}
+}
+
+// fixedFromChinese returns the fixed day number of d. The result is only
+// meaningful if d exists, which callers check by converting back.
+func fixedFromChinese(d ChineseDate) int {
+	elapsed := d.Year + 2637
+	midYear := floor(chineseEpoch + (float64(elapsed-1)+0.5)*meanTropicalYear)
+	newYear := chineseNewYearOnOrBefore(midYear)
+
+	p := chineseNewMoonOnOrAfter(newYear + (d.Month-1)*29)
+	c := chineseFromFixed(p)
+	if c.Month != d.Month || c.Leap != d.Leap {
+		p = chineseNewMoonOnOrAfter(p + 1)
+	}
+	return p + d.Day - 1
+}
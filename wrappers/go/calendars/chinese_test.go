@@ -0,0 +1,77 @@
+package calendars
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+	"github.com/mozrin/universal_timestamp/civil"
+)
+
+func TestChineseFromCivil(t *testing.T) {
+	tests := []struct {
+		civil    civil.Date
+		expected ChineseDate
+	}{
+		{civil.Date{Year: 2024, Month: time.February, Day: 10}, ChineseDate{2024, 1, false, 1}},
+		{civil.Date{Year: 2024, Month: time.February, Day: 9}, ChineseDate{2023, 12, false, 30}},
+		{civil.Date{Year: 2025, Month: time.January, Day: 29}, ChineseDate{2025, 1, false, 1}},
+		{civil.Date{Year: 2023, Month: time.March, Day: 22}, ChineseDate{2023, 2, true, 1}},
+		{civil.Date{Year: 2023, Month: time.April, Day: 20}, ChineseDate{2023, 3, false, 1}},
+		{civil.Date{Year: 2020, Month: time.May, Day: 23}, ChineseDate{2020, 4, true, 1}},
+		{civil.Date{Year: 2033, Month: time.December, Day: 22}, ChineseDate{2033, 11, true, 1}},
+		{civil.Date{Year: 2024, Month: time.December, Day: 14}, ChineseDate{2024, 11, false, 14}},
+		{civil.Date{Year: 1985, Month: time.February, Day: 20}, ChineseDate{1985, 1, false, 1}},
+		{civil.Date{Year: 1900, Month: time.January, Day: 31}, ChineseDate{1900, 1, false, 1}},
+	}
+	for _, tt := range tests {
+		got := ChineseFromCivil(tt.civil)
+		if got != tt.expected {
+			t.Errorf("ChineseFromCivil(%s) = %s, expected %s", tt.civil, got, tt.expected)
+			continue
+		}
+		back, err := got.Civil()
+		if err != nil || back != tt.civil {
+			t.Errorf("%s.Civil() = %s, %v, expected %s", got, back, err, tt.civil)
+		}
+	}
+}
+
+func TestChineseYearName(t *testing.T) {
+	tests := map[int]string{2024: "甲辰", 2025: "乙巳", 1984: "甲子", 2043: "癸亥"}
+	for year, expected := range tests {
+		if got := (ChineseDate{Year: year, Month: 1, Day: 1}).YearName(); got != expected {
+			t.Errorf("YearName(%d) = %s, expected %s", year, got, expected)
+		}
+	}
+}
+
+func TestChineseValidity(t *testing.T) {
+	for _, d := range []ChineseDate{
+		{2024, 2, true, 1},
+		{2024, 13, false, 1},
+		{2024, 1, false, 31},
+	} {
+		if _, err := d.Civil(); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%s.Civil() error = %v, expected ErrInvalid", d, err)
+		}
+	}
+	if s := (ChineseDate{2023, 2, true, 1}).String(); s != "2023-02L-01" {
+		t.Errorf("String() = %s, expected 2023-02L-01", s)
+	}
+}
+
+func TestChineseOf(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	ts, _ := uts.Parse("2024-02-09T16:30:00Z")
+	if d := ChineseOf(ts, loc); d != (ChineseDate{2024, 1, false, 1}) {
+		t.Errorf("ChineseOf(Shanghai) = %s", d)
+	}
+	if d := ChineseOf(ts, nil); d != (ChineseDate{2023, 12, false, 30}) {
+		t.Errorf("ChineseOf(UTC) = %s", d)
+	}
+}
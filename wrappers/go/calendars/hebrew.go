@@ -0,0 +1,213 @@
+package calendars
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+	"github.com/mozrin/universal_timestamp/civil"
+)
+
+// HebrewMonth is a month of the Hebrew calendar, numbered from Nisan as in
+// the Bible. The year begins in Tishrei, the seventh month.
+type HebrewMonth int
+
+const (
+	Nisan HebrewMonth = 1 + iota
+	Iyyar
+	Sivan
+	Tammuz
+	Av
+	Elul
+	Tishrei
+	Marheshvan
+	Kislev
+	Tevet
+	Shevat
+	// Adar is the twelfth month; in leap years it is called Adar I.
+	Adar
+	// AdarII is the thirteenth month, present only in leap years.
+	AdarII
+)
+
+var hebrewMonthNames = [...]string{
+	Nisan: "Nisan", Iyyar: "Iyyar", Sivan: "Sivan", Tammuz: "Tammuz",
+	Av: "Av", Elul: "Elul", Tishrei: "Tishrei", Marheshvan: "Marheshvan",
+	Kislev: "Kislev", Tevet: "Tevet", Shevat: "Shevat", Adar: "Adar",
+	AdarII: "Adar II",
+}
+
+// String returns the English name of the month.
+func (m HebrewMonth) String() string {
+	if m >= Nisan && m <= AdarII {
+		return hebrewMonthNames[m]
+	}
+	return "HebrewMonth(" + strconv.Itoa(int(m)) + ")"
+}
+
+// HebrewDate is a date in the arithmetic Hebrew calendar, with years
+// counted Anno Mundi.
+type HebrewDate struct {
+	Year  int
+	Month HebrewMonth
+	Day   int
+}
+
+// hebrewEpoch is the fixed day number of 1 Tishrei AM 1.
+const hebrewEpoch = -1373427
+
+// IsHebrewLeapYear reports whether year has the extra month Adar II.
+func IsHebrewLeapYear(year int) bool {
+	return mod(7*year+1, 19) < 7
+}
+
+// HebrewOf returns the Hebrew date of ts as observed in loc. A nil loc is
+// treated as UTC.
+func HebrewOf(ts uts.Timestamp, loc *time.Location) HebrewDate {
+	return hebrewFromFixed(fixedOf(ts, loc))
+}
+
+// HebrewFromCivil returns the Hebrew date of the Gregorian date d.
+func HebrewFromCivil(d civil.Date) HebrewDate {
+	return hebrewFromFixed(fixedFromCivil(d))
+}
+
+// IsValid reports whether the date exists in the calendar.
+func (d HebrewDate) IsValid() bool {
+	return d.Year >= 1 && d.Month >= Nisan && d.Month <= lastHebrewMonth(d.Year) &&
+		d.Day >= 1 && d.Day <= hebrewMonthLength(d.Year, d.Month)
+}
+
+// Civil returns the Gregorian date of d. It returns ErrInvalid if d does
+// not exist.
+func (d HebrewDate) Civil() (civil.Date, error) {
+	if !d.IsValid() {
+		return civil.Date{}, fmt.Errorf("%w: %s", ErrInvalid, d)
+	}
+	return civilFromFixed(fixedFromHebrew(d)), nil
+}
+
+// Start returns the first instant of d in loc. A nil loc is treated as
+// UTC.
+func (d HebrewDate) Start(loc *time.Location) (uts.Timestamp, error) {
+	c, err := d.Civil()
+	if err != nil {
+		return 0, err
+	}
+	return c.Start(loc), nil
+}
+
+// String formats the date as "1 Tishrei 5785". In leap years the twelfth
+// month is written "Adar I".
+func (d HebrewDate) String() string {
+	month := d.Month.String()
+	if d.Month == Adar && IsHebrewLeapYear(d.Year) {
+		month = "Adar I"
+	}
+	return fmt.Sprintf("%d %s %d", d.Day, month, d.Year)
+}
+
+// lastHebrewMonth returns the number of months in year.
+func lastHebrewMonth(year int) HebrewMonth {
+	if IsHebrewLeapYear(year) {
+		return AdarII
+	}
+	return Adar
+}
+
+// hebrewElapsedDays returns the days from the epoch to the molad-based
+// new year of year, before the postponements that depend on year length.
+func hebrewElapsedDays(year int) int {
+	months := floor(float64(235*year-234) / 19)
+	parts := 12084 + 13753*int64(months)
+	days := 29*months + int(floorDiv(parts, 25920))
+	if mod(3*(days+1), 7) < 3 {
+		return days + 1
+	}
+	return days
+}
+
+// hebrewYearLengthCorrection returns the extra postponement of the new
+// year needed to keep year lengths legal.
+func hebrewYearLengthCorrection(year int) int {
+	ny0 := hebrewElapsedDays(year - 1)
+	ny1 := hebrewElapsedDays(year)
+	ny2 := hebrewElapsedDays(year + 1)
+	switch {
+	case ny2-ny1 == 356:
+		return 2
+	case ny1-ny0 == 382:
+		return 1
+	}
+	return 0
+}
+
+// hebrewNewYear returns the fixed day number of 1 Tishrei of year.
+func hebrewNewYear(year int) int {
+	return hebrewEpoch + hebrewElapsedDays(year) + hebrewYearLengthCorrection(year)
+}
+
+// hebrewYearLength returns the number of days in year.
+func hebrewYearLength(year int) int {
+	return hebrewNewYear(year+1) - hebrewNewYear(year)
+}
+
+// hebrewMonthLength returns the number of days in month of year.
+func hebrewMonthLength(year int, month HebrewMonth) int {
+	switch month {
+	case Iyyar, Tammuz, Elul, Tevet, AdarII:
+		return 29
+	case Adar:
+		if !IsHebrewLeapYear(year) {
+			return 29
+		}
+	case Marheshvan:
+		if n := hebrewYearLength(year); n != 355 && n != 385 {
+			return 29
+		}
+	case Kislev:
+		if n := hebrewYearLength(year); n == 353 || n == 383 {
+			return 29
+		}
+	}
+	return 30
+}
+
+// fixedFromHebrew returns the fixed day number of d, which must be valid.
+func fixedFromHebrew(d HebrewDate) int {
+	n := hebrewNewYear(d.Year) + d.Day - 1
+	if d.Month < Tishrei {
+		for m := Tishrei; m <= lastHebrewMonth(d.Year); m++ {
+			n += hebrewMonthLength(d.Year, m)
+		}
+		for m := Nisan; m < d.Month; m++ {
+			n += hebrewMonthLength(d.Year, m)
+		}
+	} else {
+		for m := Tishrei; m < d.Month; m++ {
+			n += hebrewMonthLength(d.Year, m)
+		}
+	}
+	return n
+}
+
+// hebrewFromFixed returns the Hebrew date of fixed day number n.
+func hebrewFromFixed(n int) HebrewDate {
+	approx := floor(float64(n-hebrewEpoch)/(35975351.0/98496)) + 1
+	year := approx - 1
+	for hebrewNewYear(year+1) <= n {
+		year++
+	}
+
+	start := Tishrei
+	if n >= fixedFromHebrew(HebrewDate{Year: year, Month: Nisan, Day: 1}) {
+		start = Nisan
+	}
+	month := start
+	for n > fixedFromHebrew(HebrewDate{Year: year, Month: month, Day: hebrewMonthLength(year, month)}) {
+		month++
+	}
+	day := n - fixedFromHebrew(HebrewDate{Year: year, Month: month, Day: 1}) + 1
+	return HebrewDate{Year: year, Month: month, Day: day}
+}
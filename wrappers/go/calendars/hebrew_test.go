@@ -0,0 +1,88 @@
+package calendars
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+	"github.com/mozrin/universal_timestamp/civil"
+)
+
+func TestHebrewFromCivil(t *testing.T) {
+	tests := []struct {
+		civil    civil.Date
+		expected HebrewDate
+	}{
+		{civil.Date{Year: 2024, Month: time.October, Day: 3}, HebrewDate{5785, Tishrei, 1}},
+		{civil.Date{Year: 2023, Month: time.September, Day: 16}, HebrewDate{5784, Tishrei, 1}},
+		{civil.Date{Year: 2024, Month: time.April, Day: 23}, HebrewDate{5784, Nisan, 15}},
+		{civil.Date{Year: 2024, Month: time.March, Day: 24}, HebrewDate{5784, AdarII, 14}},
+		{civil.Date{Year: 2024, Month: time.February, Day: 24}, HebrewDate{5784, Adar, 15}},
+		{civil.Date{Year: 2024, Month: time.December, Day: 26}, HebrewDate{5785, Kislev, 25}},
+		{civil.Date{Year: 1948, Month: time.May, Day: 14}, HebrewDate{5708, Iyyar, 5}},
+		{civil.Date{Year: 1970, Month: time.January, Day: 1}, HebrewDate{5730, Tevet, 23}},
+	}
+	for _, tt := range tests {
+		got := HebrewFromCivil(tt.civil)
+		if got != tt.expected {
+			t.Errorf("HebrewFromCivil(%s) = %s, expected %s", tt.civil, got, tt.expected)
+			continue
+		}
+		back, err := got.Civil()
+		if err != nil || back != tt.civil {
+			t.Errorf("%s.Civil() = %s, %v, expected %s", got, back, err, tt.civil)
+		}
+	}
+}
+
+func TestHebrewRoundTrip(t *testing.T) {
+	start := fixedFromCivil(civil.Date{Year: 2020, Month: time.January, Day: 1})
+	prev := hebrewFromFixed(start - 1)
+	for n := start; n < start+3*366; n++ {
+		d := hebrewFromFixed(n)
+		if !d.IsValid() {
+			t.Fatalf("day %d gave invalid %s", n, d)
+		}
+		if fixedFromHebrew(d) != n {
+			t.Fatalf("round trip of %s = %d, expected %d", d, fixedFromHebrew(d), n)
+		}
+		if d.Day != prev.Day+1 && d.Day != 1 {
+			t.Fatalf("%s follows %s", d, prev)
+		}
+		prev = d
+	}
+}
+
+func TestHebrewValidity(t *testing.T) {
+	if !IsHebrewLeapYear(5784) || IsHebrewLeapYear(5785) {
+		t.Error("IsHebrewLeapYear wrong for 5784/5785")
+	}
+	for _, d := range []HebrewDate{
+		{5785, AdarII, 1},
+		{5785, Adar, 30},
+		{5785, Iyyar, 30},
+		{5785, HebrewMonth(14), 1},
+	} {
+		if _, err := d.Civil(); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%+v.Civil() error = %v, expected ErrInvalid", d, err)
+		}
+	}
+	if s := (HebrewDate{5784, Adar, 1}).String(); s != "1 Adar I 5784" {
+		t.Errorf("String() = %s, expected 1 Adar I 5784", s)
+	}
+}
+
+func TestHebrewOf(t *testing.T) {
+	ts, _ := uts.Parse("2024-10-02T23:30:00Z")
+	if d := HebrewOf(ts, nil); d != (HebrewDate{5784, Elul, 29}) {
+		t.Errorf("HebrewOf(UTC) = %s", d)
+	}
+	if d := HebrewOf(ts, time.FixedZone("", 3*3600)); d != (HebrewDate{5785, Tishrei, 1}) {
+		t.Errorf("HebrewOf(+03:00) = %s", d)
+	}
+	start, err := (HebrewDate{5785, Tishrei, 1}).Start(nil)
+	if err != nil || start.Format() != "2024-10-03T00:00:00Z" {
+		t.Errorf("Start() = %s, %v", start.Format(), err)
+	}
+}
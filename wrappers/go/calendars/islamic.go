@@ -0,0 +1,151 @@
+package calendars
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+	"github.com/mozrin/universal_timestamp/civil"
+)
+
+// IslamicMonth is a month of the Islamic calendar, numbered from 1.
+type IslamicMonth int
+
+const (
+	Muharram IslamicMonth = 1 + iota
+	Safar
+	RabiAlAwwal
+	RabiAlThani
+	JumadaAlUla
+	JumadaAlAkhirah
+	Rajab
+	Shaban
+	Ramadan
+	Shawwal
+	DhuAlQadah
+	DhuAlHijjah
+)
+
+var islamicMonthNames = [...]string{
+	Muharram: "Muharram", Safar: "Safar", RabiAlAwwal: "Rabi al-Awwal",
+	RabiAlThani: "Rabi al-Thani", JumadaAlUla: "Jumada al-Ula",
+	JumadaAlAkhirah: "Jumada al-Akhirah", Rajab: "Rajab", Shaban: "Shaban",
+	Ramadan: "Ramadan", Shawwal: "Shawwal", DhuAlQadah: "Dhu al-Qadah",
+	DhuAlHijjah: "Dhu al-Hijjah",
+}
+
+// String returns the English transliteration of the month's name.
+func (m IslamicMonth) String() string {
+	if m >= Muharram && m <= DhuAlHijjah {
+		return islamicMonthNames[m]
+	}
+	return "IslamicMonth(" + strconv.Itoa(int(m)) + ")"
+}
+
+// IslamicDate is a date in the Umm al-Qura calendar of Saudi Arabia, with
+// years counted Anno Hegirae.
+//
+// A month begins on the day after the 29th when, at sunset in Mecca that
+// evening, the conjunction has already occurred and the Moon sets after
+// the Sun; otherwise the month has 30 days. This has been the official
+// rule since 1423 AH (2002) and is applied to all years, so dates before
+// then can differ from the historical tables.
+type IslamicDate struct {
+	Year  int
+	Month IslamicMonth
+	Day   int
+}
+
+// Mecca's latitude, east longitude and UTC offset in days.
+const (
+	meccaLatitude  = 21.4225
+	meccaLongitude = 39.8262
+	meccaOffset    = 3.0 / 24
+)
+
+// islamicLunationOffset converts Meeus lunation numbers to Islamic month
+// numbers counted from Muharram AH 1, anchored on 1 Ramadan 1445, which
+// followed the new moon of 2024-03-10.
+const islamicLunationOffset = 17037
+
+// IslamicOf returns the Umm al-Qura date of ts as observed in loc. A nil
+// loc is treated as UTC.
+func IslamicOf(ts uts.Timestamp, loc *time.Location) IslamicDate {
+	return islamicFromFixed(fixedOf(ts, loc))
+}
+
+// IslamicFromCivil returns the Umm al-Qura date of the Gregorian date d.
+func IslamicFromCivil(d civil.Date) IslamicDate {
+	return islamicFromFixed(fixedFromCivil(d))
+}
+
+// IsValid reports whether the date exists in the calendar.
+func (d IslamicDate) IsValid() bool {
+	if d.Year < 1 || d.Month < Muharram || d.Month > DhuAlHijjah || d.Day < 1 || d.Day > 30 {
+		return false
+	}
+	k := d.lunation()
+	return d.Day <= islamicMonthStart(k+1)-islamicMonthStart(k)
+}
+
+// Civil returns the Gregorian date of d. It returns ErrInvalid if d does
+// not exist.
+func (d IslamicDate) Civil() (civil.Date, error) {
+	if !d.IsValid() {
+		return civil.Date{}, fmt.Errorf("%w: %s", ErrInvalid, d)
+	}
+	return civilFromFixed(islamicMonthStart(d.lunation()) + d.Day - 1), nil
+}
+
+// Start returns the first instant of d in loc. A nil loc is treated as
+// UTC.
+func (d IslamicDate) Start(loc *time.Location) (uts.Timestamp, error) {
+	c, err := d.Civil()
+	if err != nil {
+		return 0, err
+	}
+	return c.Start(loc), nil
+}
+
+// String formats the date as "1 Ramadan 1445 AH".
+func (d IslamicDate) String() string {
+	return fmt.Sprintf("%d %s %d AH", d.Day, d.Month, d.Year)
+}
+
+// lunation returns the Meeus lunation number of the new moon that begins
+// d's month.
+func (d IslamicDate) lunation() int {
+	return (d.Year-1)*12 + int(d.Month) - 1 - islamicLunationOffset
+}
+
+// islamicMonthStart returns the fixed day number on which the month that
+// follows new moon k begins.
+func islamicMonthStart(k int) int {
+	conj := newMoon(k)
+	day := floor(conj + meccaOffset)
+	for {
+		set := sunset(float64(day)+0.5-meccaOffset, meccaLatitude, meccaLongitude)
+		if conj < set && moonAboveHorizon(set, meccaLatitude, meccaLongitude) {
+			return day + 1
+		}
+		day++
+	}
+}
+
+// islamicFromFixed returns the Umm al-Qura date of fixed day number n.
+func islamicFromFixed(n int) IslamicDate {
+	k := lunationBefore(float64(n))
+	for islamicMonthStart(k) > n {
+		k--
+	}
+	for islamicMonthStart(k+1) <= n {
+		k++
+	}
+	month := k + islamicLunationOffset
+	return IslamicDate{
+		Year:  quotient(month, 12) + 1,
+		Month: IslamicMonth(mod(month, 12) + 1),
+		Day:   n - islamicMonthStart(k) + 1,
+	}
+}
@@ -0,0 +1,68 @@
+package calendars
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mozrin/universal_timestamp/civil"
+)
+
+func TestIslamicFromCivil(t *testing.T) {
+	tests := []struct {
+		civil    civil.Date
+		expected IslamicDate
+	}{
+		{civil.Date{Year: 2024, Month: time.March, Day: 11}, IslamicDate{1445, Ramadan, 1}},
+		{civil.Date{Year: 2024, Month: time.April, Day: 10}, IslamicDate{1445, Shawwal, 1}},
+		{civil.Date{Year: 2024, Month: time.June, Day: 16}, IslamicDate{1445, DhuAlHijjah, 10}},
+		{civil.Date{Year: 2024, Month: time.July, Day: 7}, IslamicDate{1446, Muharram, 1}},
+		{civil.Date{Year: 2023, Month: time.March, Day: 23}, IslamicDate{1444, Ramadan, 1}},
+		{civil.Date{Year: 2023, Month: time.April, Day: 21}, IslamicDate{1444, Shawwal, 1}},
+		{civil.Date{Year: 2023, Month: time.July, Day: 19}, IslamicDate{1445, Muharram, 1}},
+		{civil.Date{Year: 2025, Month: time.March, Day: 1}, IslamicDate{1446, Ramadan, 1}},
+		{civil.Date{Year: 2025, Month: time.March, Day: 30}, IslamicDate{1446, Shawwal, 1}},
+		{civil.Date{Year: 2020, Month: time.May, Day: 24}, IslamicDate{1441, Shawwal, 1}},
+		{civil.Date{Year: 2010, Month: time.September, Day: 10}, IslamicDate{1431, Shawwal, 1}},
+	}
+	for _, tt := range tests {
+		got := IslamicFromCivil(tt.civil)
+		if got != tt.expected {
+			t.Errorf("IslamicFromCivil(%s) = %s, expected %s", tt.civil, got, tt.expected)
+			continue
+		}
+		back, err := got.Civil()
+		if err != nil || back != tt.civil {
+			t.Errorf("%s.Civil() = %s, %v, expected %s", got, back, err, tt.civil)
+		}
+	}
+}
+
+func TestIslamicMonthLengths(t *testing.T) {
+	start := fixedFromCivil(civil.Date{Year: 2000, Month: time.January, Day: 1})
+	k := lunationBefore(float64(start))
+	for i := 0; i < 300; i++ {
+		if n := islamicMonthStart(k+i+1) - islamicMonthStart(k+i); n != 29 && n != 30 {
+			t.Fatalf("month after lunation %d has %d days", k+i, n)
+		}
+	}
+}
+
+func TestIslamicValidity(t *testing.T) {
+	// Ramadan 1445 had 30 days and Shawwal 1445 had 29.
+	if !(IslamicDate{1445, Ramadan, 30}).IsValid() {
+		t.Error("30 Ramadan 1445 reported invalid")
+	}
+	for _, d := range []IslamicDate{
+		{1445, Shawwal, 30},
+		{1445, IslamicMonth(13), 1},
+		{1445, Ramadan, 0},
+	} {
+		if _, err := d.Civil(); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%+v.Civil() error = %v, expected ErrInvalid", d, err)
+		}
+	}
+	if s := (IslamicDate{1445, Ramadan, 1}).String(); s != "1 Ramadan 1445 AH" {
+		t.Errorf("String() = %s", s)
+	}
+}
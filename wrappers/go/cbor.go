@@ -0,0 +1,225 @@
+package universal_timestamp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// CBOR tag numbers for date and time data items, from RFC 8949 section
+// 3.4.
+const (
+	// CBORTagDateTime marks an RFC 3339 text string.
+	CBORTagDateTime = 0
+	// CBORTagEpoch marks a number of seconds since the Unix epoch.
+	CBORTagEpoch = 1
+)
+
+// CBOR major types used below.
+const (
+	cborUnsigned = 0
+	cborNegative = 1
+	cborText     = 3
+	cborTag      = 6
+	cborSimple   = 7
+)
+
+// CBORDateTime is a Timestamp marshaled to CBOR as tag 0, an RFC 3339
+// string such as "2024-12-14T12:00:00Z" with minimal fractional digits.
+// Its MarshalCBOR and UnmarshalCBOR methods match the interfaces used by
+// the common Go CBOR libraries, so a struct field's type selects the
+// encoding.
+type CBORDateTime Timestamp
+
+// CBOREpoch is a Timestamp marshaled to CBOR as tag 1: an integer number of
+// seconds when the timestamp is a whole second, and a float64 otherwise,
+// which keeps sub-microsecond precision for present-day dates. Constrained
+// devices usually prefer it to CBORDateTime for its size.
+type CBOREpoch Timestamp
+
+// Timestamp returns t as a Timestamp.
+func (t CBORDateTime) Timestamp() Timestamp {
+	return Timestamp(t)
+}
+
+// Timestamp returns t as a Timestamp.
+func (t CBOREpoch) Timestamp() Timestamp {
+	return Timestamp(t)
+}
+
+// MarshalCBOR encodes t as a tag 0 text string.
+func (t CBORDateTime) MarshalCBOR() ([]byte, error) {
+	s := appendFormatC(nil, Timestamp(t), true)
+	b := cborAppendHead(nil, cborTag, CBORTagDateTime)
+	b = cborAppendHead(b, cborText, uint64(len(s)))
+	return append(b, s...), nil
+}
+
+// MarshalCBOR encodes t as a tag 1 integer or float64.
+func (t CBOREpoch) MarshalCBOR() ([]byte, error) {
+	b := cborAppendHead(nil, cborTag, CBORTagEpoch)
+	if t%CBOREpoch(Second) != 0 {
+		b = append(b, cborSimple<<5|27)
+		return binary.BigEndian.AppendUint64(b, math.Float64bits(float64(t)/float64(Second))), nil
+	}
+	secs := int64(t) / int64(Second)
+	if secs < 0 {
+		return cborAppendHead(b, cborNegative, uint64(-1-secs)), nil
+	}
+	return cborAppendHead(b, cborUnsigned, uint64(secs)), nil
+}
+
+// UnmarshalCBOR decodes a tag 0 or tag 1 data item, so either encoding is
+// accepted regardless of the field type. Tag 0 strings may carry a numeric
+// UTC offset, and fractions beyond nanoseconds are truncated. It returns ErrInvalidFormat for
+// other input and ErrOutOfRange for instants a Timestamp cannot hold.
+func (t *CBORDateTime) UnmarshalCBOR(data []byte) error {
+	ts, err := unmarshalCBORTime(data)
+	if err == nil {
+		*t = CBORDateTime(ts)
+	}
+	return err
+}
+
+// UnmarshalCBOR decodes a tag 0 or tag 1 data item, as for CBORDateTime.
+func (t *CBOREpoch) UnmarshalCBOR(data []byte) error {
+	ts, err := unmarshalCBORTime(data)
+	if err == nil {
+		*t = CBOREpoch(ts)
+	}
+	return err
+}
+
+// unmarshalCBORTime decodes a single tagged CBOR date/time data item.
+func unmarshalCBORTime(data []byte) (Timestamp, error) {
+	major, tag, rest, ok := cborReadHead(data)
+	if !ok || major != cborTag || tag > CBORTagEpoch {
+		return 0, fmt.Errorf("%w: expected a CBOR tag 0 or 1 date/time", ErrInvalidFormat)
+	}
+	if len(rest) == 0 {
+		return 0, fmt.Errorf("%w: truncated CBOR date/time", ErrInvalidFormat)
+	}
+
+	if rest[0] == cborSimple<<5|25 || rest[0] == cborSimple<<5|26 || rest[0] == cborSimple<<5|27 {
+		f, rest, ok := cborReadFloat(rest)
+		if !ok || len(rest) != 0 || tag != CBORTagEpoch {
+			return 0, fmt.Errorf("%w: malformed CBOR epoch", ErrInvalidFormat)
+		}
+		return cborFloatTimestamp(f)
+	}
+
+	major, n, rest, ok := cborReadHead(rest)
+	switch {
+	case !ok:
+		return 0, fmt.Errorf("%w: truncated CBOR date/time", ErrInvalidFormat)
+	case tag == CBORTagDateTime && major == cborText && uint64(len(rest)) == n:
+		ts, _, err := ParseWithOffset(string(rest), MaxFractionDigits(18))
+		return ts, err
+	case tag == CBORTagEpoch && len(rest) == 0 && (major == cborUnsigned || major == cborNegative):
+		if n > math.MaxInt64 {
+			return 0, fmt.Errorf("%w: CBOR epoch out of range", ErrOutOfRange)
+		}
+		secs := int64(n)
+		if major == cborNegative {
+			secs = -1 - secs
+		}
+		if err := checkEpoch(secs, Second); err != nil {
+			return 0, fmt.Errorf("%w: CBOR epoch out of range", err)
+		}
+		return Timestamp(secs * int64(Second)), nil
+	}
+	return 0, fmt.Errorf("%w: malformed CBOR date/time", ErrInvalidFormat)
+}
+
+// cborFloatTimestamp converts a float number of seconds to a Timestamp,
+// rounding to the nearest nanosecond.
+func cborFloatTimestamp(f float64) (Timestamp, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("%w: non-finite CBOR epoch", ErrInvalidFormat)
+	}
+	// The bounds leave room for the fraction added below.
+	secs := math.Floor(f)
+	if secs >= float64(math.MaxInt64/int64(Second)) || secs < float64(math.MinInt64/int64(Second)) {
+		return 0, fmt.Errorf("%w: CBOR epoch out of range", ErrOutOfRange)
+	}
+	return Timestamp(int64(secs)*int64(Second) + int64(math.Round((f-secs)*float64(Second)))), nil
+}
+
+// cborAppendHead appends the initial byte and argument of a data item of
+// the given major type.
+func cborAppendHead(b []byte, major byte, n uint64) []byte {
+	major <<= 5
+	switch {
+	case n < 24:
+		return append(b, major|byte(n))
+	case n <= math.MaxUint8:
+		return append(b, major|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, major|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, major|26), uint32(n))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, major|27), n)
+	}
+}
+
+// cborReadHead reads the major type and argument at the start of b.
+func cborReadHead(b []byte) (major byte, n uint64, rest []byte, ok bool) {
+	if len(b) == 0 {
+		return 0, 0, nil, false
+	}
+	major, info := b[0]>>5, b[0]&0x1f
+	b = b[1:]
+	switch {
+	case info < 24:
+		return major, uint64(info), b, true
+	case info == 24 && len(b) >= 1:
+		return major, uint64(b[0]), b[1:], true
+	case info == 25 && len(b) >= 2:
+		return major, uint64(binary.BigEndian.Uint16(b)), b[2:], true
+	case info == 26 && len(b) >= 4:
+		return major, uint64(binary.BigEndian.Uint32(b)), b[4:], true
+	case info == 27 && len(b) >= 8:
+		return major, binary.BigEndian.Uint64(b), b[8:], true
+	}
+	return 0, 0, nil, false
+}
+
+// cborReadFloat reads a half-, single- or double-precision float.
+func cborReadFloat(b []byte) (float64, []byte, bool) {
+	_, bits, rest, ok := cborReadHead(b)
+	if !ok {
+		return 0, nil, false
+	}
+	switch b[0] & 0x1f {
+	case 25:
+		return halfToFloat64(uint16(bits)), rest, true
+	case 26:
+		return float64(math.Float32frombits(uint32(bits))), rest, true
+	default:
+		return math.Float64frombits(bits), rest, true
+	}
+}
+
+// halfToFloat64 converts an IEEE 754 half-precision value.
+func halfToFloat64(h uint16) float64 {
+	exp := int(h >> 10 & 0x1f)
+	mant := float64(h & 0x3ff)
+	var f float64
+	switch exp {
+	case 0:
+		f = math.Ldexp(mant, -24)
+	case 31:
+		if mant == 0 {
+			f = math.Inf(1)
+		} else {
+			f = math.NaN()
+		}
+	default:
+		f = math.Ldexp(mant+1024, exp-25)
+	}
+	if h&0x8000 != 0 {
+		f = -f
+	}
+	return f
+}
@@ -0,0 +1,108 @@
+package universal_timestamp
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestCBORDateTime(t *testing.T) {
+	// RFC 8949 appendix A: 0("2013-03-21T20:04:00Z").
+	want, _ := hex.DecodeString("c074323031332d30332d32315432303a30343a30305a")
+	ts := mustParse(t, "2013-03-21T20:04:00Z")
+
+	got, err := CBORDateTime(ts).MarshalCBOR()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("MarshalCBOR() = %x, expected %x", got, want)
+	}
+
+	var back CBORDateTime
+	if err := back.UnmarshalCBOR(want); err != nil {
+		t.Fatal(err)
+	}
+	if back.Timestamp() != ts {
+		t.Errorf("UnmarshalCBOR() = %s, expected %s", back.Timestamp().Format(), ts.Format())
+	}
+}
+
+func TestCBOREpoch(t *testing.T) {
+	cases := []struct {
+		encoded string
+		ts      string
+	}{
+		// RFC 8949 appendix A: 1(1363896240) and 1(1363896240.5).
+		{"c11a514b67b0", "2013-03-21T20:04:00Z"},
+		{"c1fb41d452d9ec200000", "2013-03-21T20:04:00.5Z"},
+		{"c13a0001517f", "1969-12-31T00:00:00Z"},
+	}
+	for _, c := range cases {
+		want, _ := hex.DecodeString(c.encoded)
+		ts := mustParse(t, c.ts)
+
+		got, err := CBOREpoch(ts).MarshalCBOR()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("MarshalCBOR(%s) = %x, expected %x", c.ts, got, want)
+		}
+
+		var back CBOREpoch
+		if err := back.UnmarshalCBOR(want); err != nil {
+			t.Fatalf("UnmarshalCBOR(%s) error: %v", c.encoded, err)
+		}
+		if back.Timestamp() != ts {
+			t.Errorf("UnmarshalCBOR(%s) = %s, expected %s", c.encoded, back.Timestamp().Format(), c.ts)
+		}
+	}
+}
+
+func TestUnmarshalCBORInterop(t *testing.T) {
+	cases := []struct {
+		encoded string
+		ts      string
+	}{
+		// A tag 0 string with an offset, decoded into an epoch field.
+		{"c07819323031332d30332d32315432313a30343a30302b30313a3030", "2013-03-21T20:04:00Z"},
+		// Half- and single-precision epochs.
+		{"c1f93c00", "1970-01-01T00:00:01Z"},
+		{"c1fa3fc00000", "1970-01-01T00:00:01.5Z"},
+	}
+	for _, c := range cases {
+		data, _ := hex.DecodeString(c.encoded)
+		var got CBOREpoch
+		if err := got.UnmarshalCBOR(data); err != nil {
+			t.Fatalf("UnmarshalCBOR(%s) error: %v", c.encoded, err)
+		}
+		if got.Timestamp().Format() != c.ts {
+			t.Errorf("UnmarshalCBOR(%s) = %s, expected %s", c.encoded, got.Timestamp().Format(), c.ts)
+		}
+	}
+}
+
+func TestUnmarshalCBORErrors(t *testing.T) {
+	cases := []struct {
+		encoded string
+		err     error
+	}{
+		{"1a514b67b0", ErrInvalidFormat},           // untagged integer
+		{"c21a514b67b0", ErrInvalidFormat},         // tag 2
+		{"c1", ErrInvalidFormat},                   // truncated
+		{"c16161", ErrInvalidFormat},               // tag 1 text
+		{"c0fb41d452d9ec200000", ErrInvalidFormat}, // tag 0 float
+		{"c1f97e00", ErrInvalidFormat},             // NaN
+		{"c11bffffffffffffffff", ErrOutOfRange},
+		{"c1fb7e37e43c8800759c", ErrOutOfRange}, // 1e300
+	}
+	for _, c := range cases {
+		data, _ := hex.DecodeString(c.encoded)
+		var got CBORDateTime
+		if err := got.UnmarshalCBOR(data); !errors.Is(err, c.err) {
+			t.Errorf("UnmarshalCBOR(%s) error = %v, expected %v", c.encoded, err, c.err)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+// Package civil provides Date and TimeOfDay types, which describe a
+// calendar date or a wall-clock time without a zone. They are not
+// instants; converting them to a Timestamp requires a location.
+package civil
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+)
+
+// ErrInvalid is returned when a string does not describe a valid date or
+// time of day.
+var ErrInvalid = errors.New("invalid civil value")
+
+// Date is a calendar date in the proleptic Gregorian calendar, such as a
+// birthday, independent of any time zone.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// TimeOfDay is a wall-clock time, such as a store's opening hour,
+// independent of any date or time zone.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// DateOf returns the calendar date of ts as observed in loc. A nil loc is
+// treated as UTC.
+func DateOf(ts uts.Timestamp, loc *time.Location) Date {
+	return dateOf(ts.In(loc))
+}
+
+// TimeOfDayOf returns the wall-clock time of ts as observed in loc. A nil
+// loc is treated as UTC.
+func TimeOfDayOf(ts uts.Timestamp, loc *time.Location) TimeOfDay {
+	t := ts.In(loc)
+	return TimeOfDay{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}
+}
+
+// ParseDate parses a date in "YYYY-MM-DD" form.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return Date{}, fmt.Errorf("%w: %q", ErrInvalid, s)
+	}
+	return dateOf(t), nil
+}
+
+// ParseTimeOfDay parses a time in "HH:MM", "HH:MM:SS" or
+// "HH:MM:SS.fffffffff" form.
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return TimeOfDay{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}, nil
+		}
+	}
+	return TimeOfDay{}, fmt.Errorf("%w: %q", ErrInvalid, s)
+}
+
+// String formats the date as "YYYY-MM-DD".
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsValid reports whether the date exists in the calendar.
+func (d Date) IsValid() bool {
+	return dateOf(d.midnight()) == d
+}
+
+// Weekday returns the day of the week of the date.
+func (d Date) Weekday() time.Weekday {
+	return d.midnight().Weekday()
+}
+
+// AddDays returns the date n days later, or earlier for negative n.
+func (d Date) AddDays(n int) Date {
+	return dateOf(time.Date(d.Year, d.Month, d.Day+n, 0, 0, 0, 0, time.UTC))
+}
+
+// AddMonths adds n months, normalizing overflowing days as time.AddDate
+// does (January 31 plus one month is March 2 or 3).
+func (d Date) AddMonths(n int) Date {
+	return dateOf(time.Date(d.Year, d.Month+time.Month(n), d.Day, 0, 0, 0, 0, time.UTC))
+}
+
+// DaysSince returns the number of days from other to d.
+func (d Date) DaysSince(other Date) int {
+	return int(d.days() - other.days())
+}
+
+// Before reports whether d is earlier than other.
+func (d Date) Before(other Date) bool {
+	return d.days() < other.days()
+}
+
+// After reports whether d is later than other.
+func (d Date) After(other Date) bool {
+	return d.days() > other.days()
+}
+
+// Start returns the first instant of the date in loc. If midnight does not
+// exist there because of a DST change, the first existing instant is used.
+// A nil loc is treated as UTC.
+func (d Date) Start(loc *time.Location) uts.Timestamp {
+	return uts.StartOfDate(d.Year, d.Month, d.Day, loc)
+}
+
+// At returns the instant at which the wall clock in loc shows tod on the
+// date. Wall-clock times skipped or repeated by DST changes resolve as
+// time.Date does. A nil loc is treated as UTC.
+func (d Date) At(tod TimeOfDay, loc *time.Location) uts.Timestamp {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return uts.FromTime(time.Date(d.Year, d.Month, d.Day,
+		tod.Hour, tod.Minute, tod.Second, tod.Nanosecond, loc))
+}
+
+// midnight returns midnight UTC at the start of the date. Date arithmetic goes
+// through time.Time rather than Timestamp, whose nanosecond range covers
+// only the years 1678 to 2262.
+func (d Date) midnight() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// days returns the number of days from 1970-01-01 to the date.
+func (d Date) days() int64 {
+	return d.midnight().Unix() / 86400
+}
+
+// dateOf returns the date of t.
+func dateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Date) UnmarshalText(text []byte) error {
+	parsed, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// String formats the time as "HH:MM:SS", followed by fractional seconds
+// without trailing zeros when they are non-zero.
+func (t TimeOfDay) String() string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	if t.Nanosecond == 0 {
+		return s
+	}
+	frac := fmt.Sprintf("%09d", t.Nanosecond)
+	for frac[len(frac)-1] == '0' {
+		frac = frac[:len(frac)-1]
+	}
+	return s + "." + frac
+}
+
+// IsValid reports whether every field is within range.
+func (t TimeOfDay) IsValid() bool {
+	return t.Hour >= 0 && t.Hour < 24 &&
+		t.Minute >= 0 && t.Minute < 60 &&
+		t.Second >= 0 && t.Second < 60 &&
+		t.Nanosecond >= 0 && t.Nanosecond < 1e9
+}
+
+// SinceMidnight returns the wall-clock time elapsed since 00:00.
+func (t TimeOfDay) SinceMidnight() uts.Duration {
+	return uts.Duration(t.Hour)*uts.Hour + uts.Duration(t.Minute)*uts.Minute +
+		uts.Duration(t.Second)*uts.Second + uts.Duration(t.Nanosecond)
+}
+
+// Before reports whether t is earlier in the day than other.
+func (t TimeOfDay) Before(other TimeOfDay) bool {
+	return t.SinceMidnight() < other.SinceMidnight()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	parsed, err := ParseTimeOfDay(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,148 @@
+package civil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+)
+
+func TestDate(t *testing.T) {
+	d, err := ParseDate("2024-02-28")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := d.AddDays(1).String(); got != "2024-02-29" {
+		t.Errorf("AddDays(1) = %s", got)
+	}
+	if got := d.AddDays(2).DaysSince(d); got != 2 {
+		t.Errorf("DaysSince() = %d", got)
+	}
+	if d.Weekday() != time.Wednesday {
+		t.Errorf("Weekday() = %s", d.Weekday())
+	}
+	if (Date{2023, time.February, 29}).IsValid() {
+		t.Error("2023-02-29 reported valid")
+	}
+	if !d.Before(d.AddDays(1)) || d.After(d) {
+		t.Error("Before/After ordering wrong")
+	}
+	if _, err := ParseDate("2024-13-01"); err == nil {
+		t.Error("ParseDate accepted month 13")
+	}
+}
+
+func TestDateOutsideTimestampRange(t *testing.T) {
+	first := Date{1, time.January, 1}
+	last := Date{9999, time.December, 31}
+	if !first.IsValid() || !last.IsValid() {
+		t.Error("years 1 and 9999 reported invalid")
+	}
+	if got := first.Weekday(); got != time.Monday {
+		t.Errorf("0001-01-01 Weekday() = %s, expected Monday", got)
+	}
+	if got := last.Weekday(); got != time.Friday {
+		t.Errorf("9999-12-31 Weekday() = %s, expected Friday", got)
+	}
+	if got := last.DaysSince(first); got != 3652058 {
+		t.Errorf("DaysSince() = %d, expected 3652058", got)
+	}
+	if got := first.AddDays(3652058); got != last {
+		t.Errorf("AddDays() = %s, expected %s", got, last)
+	}
+	if got := last.AddDays(-1).String(); got != "9999-12-30" {
+		t.Errorf("AddDays(-1) = %s, expected 9999-12-30", got)
+	}
+	if got := (Date{1, time.January, 31}).AddMonths(1).String(); got != "0001-03-03" {
+		t.Errorf("AddMonths(1) = %s, expected 0001-03-03", got)
+	}
+	if !first.Before(last) || first.After(last) || !last.After(Date{2024, time.December, 14}) {
+		t.Error("Before/After ordering wrong outside the Timestamp range")
+	}
+	if (Date{1, time.February, 29}).IsValid() {
+		t.Error("0001-02-29 reported valid")
+	}
+}
+
+func TestDateOfZone(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	ts, _ := uts.Parse("2024-12-14T20:00:00Z")
+	if got := DateOf(ts, loc).String(); got != "2024-12-15" {
+		t.Errorf("DateOf(Tokyo) = %s", got)
+	}
+	if got := DateOf(ts, nil).String(); got != "2024-12-14" {
+		t.Errorf("DateOf(UTC) = %s", got)
+	}
+	if got := TimeOfDayOf(ts, loc).String(); got != "05:00:00" {
+		t.Errorf("TimeOfDayOf(Tokyo) = %s", got)
+	}
+}
+
+func TestStartMidnightGap(t *testing.T) {
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	// Sao Paulo clocks jumped from 00:00 to 01:00 on 2015-10-18.
+	if got := (Date{2015, time.October, 18}).Start(loc).Format(); got != "2015-10-18T03:00:00Z" {
+		t.Errorf("Start(Sao_Paulo) = %s, expected 2015-10-18T03:00:00Z", got)
+	}
+}
+
+func TestAt(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	open, _ := ParseTimeOfDay("09:30")
+	summer := Date{2024, time.July, 1}.At(open, loc)
+	winter := Date{2024, time.December, 2}.At(open, loc)
+
+	if got := summer.Format(); got != "2024-07-01T07:30:00Z" {
+		t.Errorf("summer opening = %s", got)
+	}
+	if got := winter.Format(); got != "2024-12-02T08:30:00Z" {
+		t.Errorf("winter opening = %s", got)
+	}
+}
+
+func TestTimeOfDay(t *testing.T) {
+	tod, err := ParseTimeOfDay("12:34:56.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tod.String() != "12:34:56.5" || !tod.IsValid() {
+		t.Errorf("ParseTimeOfDay() = %s", tod)
+	}
+	if tod.SinceMidnight() != 12*uts.Hour+34*uts.Minute+56*uts.Second+500*uts.Millisecond {
+		t.Errorf("SinceMidnight() = %s", tod.SinceMidnight())
+	}
+	if _, err := ParseTimeOfDay("24:00"); err == nil {
+		t.Error("ParseTimeOfDay accepted 24:00")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type shop struct {
+		Opened Date      `json:"opened"`
+		Opens  TimeOfDay `json:"opens"`
+	}
+
+	in := shop{Date{2024, time.December, 14}, TimeOfDay{Hour: 9}}
+	data, err := json.Marshal(in)
+	if err != nil || string(data) != `{"opened":"2024-12-14","opens":"09:00:00"}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+
+	var out shop
+	if err := json.Unmarshal(data, &out); err != nil || out != in {
+		t.Errorf("Unmarshal() = %+v, %v", out, err)
+	}
+}
@@ -0,0 +1,33 @@
+package universal_timestamp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// clfLayout is the time format of the Common Log Format used by Apache's
+// %t and nginx's $time_local, without the surrounding brackets.
+const clfLayout = "02/Jan/2006:15:04:05 -0700"
+
+// ParseCLF parses an access-log time such as "[14/Dec/2024:12:00:00 +0000]",
+// keeping its offset. The brackets are optional, as nginx's $time_local
+// omits them. It returns ErrInvalidFormat for other input.
+func ParseCLF(s string) (ZonedTimestamp, error) {
+	v := s
+	if strings.HasPrefix(v, "[") && strings.HasSuffix(v, "]") {
+		v = v[1 : len(v)-1]
+	}
+	t, err := time.Parse(clfLayout, v)
+	if err != nil {
+		return ZonedTimestamp{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	_, offset := t.Zone()
+	return ZonedTimestamp{Instant: FromTime(t), Location: offsetZone(offset)}, nil
+}
+
+// FormatCLF formats z in its location as an access-log time with brackets,
+// such as "[14/Dec/2024:12:00:00 +0000]". Fractional seconds are dropped.
+func FormatCLF(z ZonedTimestamp) string {
+	return z.Time().Format("[" + clfLayout + "]")
+}
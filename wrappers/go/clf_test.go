@@ -0,0 +1,57 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseCLF(t *testing.T) {
+	cases := []struct {
+		in      string
+		instant string
+		offset  int
+	}{
+		{"[14/Dec/2024:12:00:00 +0000]", "2024-12-14T12:00:00Z", 0},
+		{"14/Dec/2024:13:00:00 +0100", "2024-12-14T12:00:00Z", 3600},
+		{"[04/Jul/2024:08:00:00 -0400]", "2024-07-04T12:00:00Z", -14400},
+	}
+	for _, c := range cases {
+		z, err := ParseCLF(c.in)
+		if err != nil {
+			t.Fatalf("ParseCLF(%q) error: %v", c.in, err)
+		}
+		if got := z.Instant.Format(); got != c.instant {
+			t.Errorf("ParseCLF(%q) = %s, expected %s", c.in, got, c.instant)
+		}
+		if got := z.Offset(); got != c.offset {
+			t.Errorf("ParseCLF(%q) offset = %d, expected %d", c.in, got, c.offset)
+		}
+	}
+
+	for _, s := range []string{"", "[14/Dec/2024:12:00:00]", "14/12/2024:12:00:00 +0000", "[14/Dec/2024 12:00:00 +0000]"} {
+		if _, err := ParseCLF(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseCLF(%q) error = %v, expected ErrInvalidFormat", s, err)
+		}
+	}
+}
+
+func TestFormatCLF(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00.75Z")
+	if got := FormatCLF(NewZoned(ts, nil)); got != "[14/Dec/2024:12:00:00 +0000]" {
+		t.Errorf("FormatCLF(UTC) = %s, expected [14/Dec/2024:12:00:00 +0000]", got)
+	}
+
+	z := NewZoned(ts, time.FixedZone("", -5*3600))
+	s := FormatCLF(z)
+	if s != "[14/Dec/2024:07:00:00 -0500]" {
+		t.Errorf("FormatCLF(-05:00) = %s, expected [14/Dec/2024:07:00:00 -0500]", s)
+	}
+	back, err := ParseCLF(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.Instant != ts.TruncateDigits(0) || back.Offset() != -5*3600 {
+		t.Errorf("ParseCLF(FormatCLF()) = %s, expected %s", back, z)
+	}
+}
@@ -0,0 +1,90 @@
+package universal_timestamp
+
+import (
+	"strings"
+	"time"
+)
+
+// clickHouseMinSeconds is 1900-01-01T00:00:00Z, the lower bound of
+// ClickHouse's DateTime64 range. The upper bound (2299-12-31) lies beyond
+// what a Timestamp can hold.
+const clickHouseMinSeconds = -2208988800
+
+// clickHouseLayout is the text form ClickHouse uses for DateTime64 values.
+const clickHouseLayout = "2006-01-02 15:04:05"
+
+// ToClickHouseDateTime64 converts ts to the tick count stored by a
+// ClickHouse DateTime64(precision) column. Sub-tick nanoseconds are floored,
+// matching ClickHouse, so instants before 1970 do not round towards the
+// epoch as naive integer division would.
+func ToClickHouseDateTime64(ts Timestamp, precision int) (int64, error) {
+	scale, err := clickHouseScale(precision)
+	if err != nil {
+		return 0, err
+	}
+	if int64(ts) < clickHouseMinSeconds*int64(Second) {
+		return 0, ErrOutOfRange
+	}
+	return floorDiv(int64(ts), scale), nil
+}
+
+// FromClickHouseDateTime64 converts a DateTime64(precision) tick count to
+// a Timestamp.
+func FromClickHouseDateTime64(ticks int64, precision int) (Timestamp, error) {
+	scale, err := clickHouseScale(precision)
+	if err != nil {
+		return 0, err
+	}
+	n := ticks * scale
+	if n/scale != ticks || n < clickHouseMinSeconds*int64(Second) {
+		return 0, ErrOutOfRange
+	}
+	return Timestamp(n), nil
+}
+
+// FormatClickHouseDateTime64 renders ts as ClickHouse text
+// ("2006-01-02 15:04:05.000") with exactly precision fractional digits,
+// as wall-clock time in the server or column time zone loc. A nil loc is
+// treated as UTC.
+func FormatClickHouseDateTime64(ts Timestamp, precision int, loc *time.Location) (string, error) {
+	ticks, err := ToClickHouseDateTime64(ts, precision)
+	if err != nil {
+		return "", err
+	}
+	scale, _ := clickHouseScale(precision)
+	layout := clickHouseLayout
+	if precision > 0 {
+		layout += "." + strings.Repeat("0", precision)
+	}
+	return Timestamp(ticks * scale).In(loc).Format(layout), nil
+}
+
+// ParseClickHouseDateTime64 parses ClickHouse DateTime64 text, which carries
+// no offset, as wall-clock time in loc. Fractional digits beyond precision
+// are truncated. A nil loc is treated as UTC.
+func ParseClickHouseDateTime64(s string, precision int, loc *time.Location) (Timestamp, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	t, err := time.ParseInLocation(clickHouseLayout, s, loc)
+	if err != nil {
+		return 0, err
+	}
+	ticks, err := ToClickHouseDateTime64(FromTime(t), precision)
+	if err != nil {
+		return 0, err
+	}
+	return FromClickHouseDateTime64(ticks, precision)
+}
+
+// clickHouseScale returns the number of nanoseconds in one DateTime64 tick.
+func clickHouseScale(precision int) (int64, error) {
+	if precision < 0 || precision > 9 {
+		return 0, ErrInvalidPrecision
+	}
+	scale := int64(1)
+	for i := precision; i < 9; i++ {
+		scale *= 10
+	}
+	return scale, nil
+}
@@ -0,0 +1,66 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClickHouseDateTime64(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00.123456789Z")
+
+	cases := []struct {
+		precision int
+		ticks     int64
+	}{
+		{3, 1734177600123},
+		{6, 1734177600123456},
+		{9, 1734177600123456789},
+	}
+	for _, c := range cases {
+		got, err := ToClickHouseDateTime64(ts, c.precision)
+		if err != nil || got != c.ticks {
+			t.Errorf("ToClickHouseDateTime64(%d) = %d, %v; expected %d", c.precision, got, err, c.ticks)
+		}
+	}
+
+	back, err := FromClickHouseDateTime64(1734177600123, 3)
+	if err != nil || back.Format() != "2024-12-14T12:00:00.123Z" {
+		t.Errorf("FromClickHouseDateTime64() = %s, %v", back.Format(), err)
+	}
+}
+
+func TestClickHouseNegative(t *testing.T) {
+	ts := mustParse(t, "1969-12-31T23:59:59.9999Z")
+	got, err := ToClickHouseDateTime64(ts, 3)
+	if err != nil || got != -1 {
+		t.Errorf("ToClickHouseDateTime64(pre-epoch) = %d, %v; expected -1", got, err)
+	}
+
+	if _, err := ToClickHouseDateTime64(mustParse(t, "1899-12-31T23:59:59Z"), 3); err != ErrOutOfRange {
+		t.Errorf("expected ErrOutOfRange before 1900, got %v", err)
+	}
+	if _, err := FromClickHouseDateTime64(1<<62, 3); err != ErrOutOfRange {
+		t.Errorf("expected ErrOutOfRange on overflow, got %v", err)
+	}
+	if _, err := ToClickHouseDateTime64(ts, 10); err != ErrInvalidPrecision {
+		t.Errorf("expected ErrInvalidPrecision, got %v", err)
+	}
+}
+
+func TestClickHouseText(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	ts := mustParse(t, "2024-12-14T12:00:00.123456789Z")
+	s, err := FormatClickHouseDateTime64(ts, 3, loc)
+	if err != nil || s != "2024-12-14 13:00:00.123" {
+		t.Errorf("FormatClickHouseDateTime64() = %q, %v", s, err)
+	}
+
+	back, err := ParseClickHouseDateTime64("2024-12-14 13:00:00.123999", 3, loc)
+	if err != nil || back.Format() != "2024-12-14T12:00:00.123Z" {
+		t.Errorf("ParseClickHouseDateTime64() = %s, %v", back.Format(), err)
+	}
+}
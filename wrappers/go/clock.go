@@ -0,0 +1,182 @@
+package universal_timestamp
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is a source of the current time. Components that need the time
+// accept a Clock so tests can substitute a ManualClock.
+type Clock interface {
+	Now() Timestamp
+	// Until returns the duration from the clock's current time until ts.
+	// The result is negative if ts has passed.
+	Until(ts Timestamp) Duration
+}
+
+// systemClock reads the current time from the C core.
+type systemClock struct{}
+
+// Now returns the current UTC timestamp.
+func (systemClock) Now() Timestamp {
+	return Now()
+}
+
+// Until returns the duration until ts.
+func (systemClock) Until(ts Timestamp) Duration {
+	return Duration(ts - Now())
+}
+
+// SystemClock is the Clock backed by the system real-time clock.
+var SystemClock Clock = systemClock{}
+
+// ManualClock is a Clock whose time only changes when Set or Advance is
+// called. Timers and debouncers driven by a ManualClock fire during the
+// Set or Advance call that reaches their deadline, so tests can move
+// virtual time instead of sleeping. It is safe for concurrent use.
+type ManualClock struct {
+	mu      sync.Mutex
+	now     Timestamp
+	waiters []*clockWaiter
+}
+
+// clockWaiter is a callback scheduled on a ManualClock.
+type clockWaiter struct {
+	at Timestamp
+	f  func()
+}
+
+// NewManualClock returns a ManualClock set to start.
+func NewManualClock(start Timestamp) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+// Now returns the clock's current time.
+func (c *ManualClock) Now() Timestamp {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Until returns the duration from the clock's current time until ts.
+func (c *ManualClock) Until(ts Timestamp) Duration {
+	return Duration(ts - c.Now())
+}
+
+// Set moves the clock to ts, running any callbacks that have come due.
+func (c *ManualClock) Set(ts Timestamp) {
+	c.mu.Lock()
+	c.now = ts
+	c.fire()
+}
+
+// Advance moves the clock forward by d and returns the new time, running
+// any callbacks that have come due.
+func (c *ManualClock) Advance(d Duration) Timestamp {
+	c.mu.Lock()
+	c.now += Timestamp(d)
+	now := c.now
+	c.fire()
+	return now
+}
+
+// fire removes the waiters due at the current time and, after releasing
+// c.mu, runs them in deadline order on the calling goroutine.
+func (c *ManualClock) fire() {
+	var due []*clockWaiter
+	kept := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.at <= c.now {
+			due = append(due, w)
+		} else {
+			kept = append(kept, w)
+		}
+	}
+	c.waiters = kept
+	c.mu.Unlock()
+
+	sort.SliceStable(due, func(i, j int) bool { return due[i].at < due[j].at })
+	for _, w := range due {
+		w.f()
+	}
+}
+
+// afterFunc schedules f to run once the clock reaches ts. A deadline that
+// has already passed runs f on a new goroutine, as time.AfterFunc does.
+func (c *ManualClock) afterFunc(ts Timestamp, f func()) func() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ts <= c.now {
+		go f()
+		return func() bool { return false }
+	}
+
+	w := &clockWaiter{at: ts, f: f}
+	c.waiters = append(c.waiters, w)
+	return func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for i, other := range c.waiters {
+			if other == w {
+				c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// afterFuncClock is implemented by clocks that can run a callback when
+// they reach a given time.
+type afterFuncClock interface {
+	afterFunc(ts Timestamp, f func()) func() bool
+}
+
+// clockAfterFunc runs f once clock reaches ts and returns a function that
+// cancels the call, reporting whether it was still pending. Clocks other
+// than ManualClock are assumed to advance in real time.
+func clockAfterFunc(clock Clock, ts Timestamp, f func()) func() bool {
+	if c, ok := clock.(afterFuncClock); ok {
+		return c.afterFunc(ts, f)
+	}
+	t := time.AfterFunc(time.Duration(clock.Until(ts)), f)
+	return t.Stop
+}
+
+// SleepUntil blocks until DefaultClock reaches ts or ctx is done,
+// whichever happens first. It returns ctx.Err() if ctx ended the wait and
+// nil otherwise, including when ts has already passed.
+func SleepUntil(ctx context.Context, ts Timestamp) error {
+	return SleepUntilClock(ctx, nil, ts)
+}
+
+// SleepUntilClock is like SleepUntil but waits for clock to reach ts. With
+// a ManualClock the wait ends during the Set or Advance call that reaches
+// ts, so code that sleeps can be driven in virtual time. A nil clock uses
+// DefaultClock.
+func SleepUntilClock(ctx context.Context, clock Clock, ts Timestamp) error {
+	clock = clockOrSystem(clock)
+	if clock.Until(ts) <= 0 {
+		return ctx.Err()
+	}
+
+	done := make(chan struct{})
+	stop := clockAfterFunc(clock, ts, func() { close(done) })
+	defer stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-done:
+		return nil
+	}
+}
+
+// clockOrSystem returns c, or DefaultClock when c is nil.
+func clockOrSystem(c Clock) Clock {
+	if c == nil {
+		return DefaultClock()
+	}
+	return c
+}
@@ -0,0 +1,120 @@
+package universal_timestamp
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSystemClock(t *testing.T) {
+	if SystemClock.Now() == 0 {
+		t.Error("SystemClock.Now() returned 0")
+	}
+}
+
+func TestManualClock(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	c := NewManualClock(start)
+
+	if c.Now() != start {
+		t.Errorf("Now() = %s, expected %s", c.Now().Format(), start.Format())
+	}
+
+	c.Advance(90 * Second)
+	if got := c.Now().Format(); got != "2024-12-14T12:01:30Z" {
+		t.Errorf("after Advance, Now() = %s", got)
+	}
+
+	c.Set(0)
+	if c.Now() != 0 {
+		t.Errorf("after Set, Now() = %d", int64(c.Now()))
+	}
+}
+
+func TestClockUntil(t *testing.T) {
+	c := NewManualClock(mustParse(t, "2024-12-14T12:00:00Z"))
+	if got := c.Until(mustParse(t, "2024-12-14T12:01:30Z")); got != 90*Second {
+		t.Errorf("Until() = %s, expected PT1M30S", got)
+	}
+	if got := c.Until(mustParse(t, "2024-12-14T11:59:00Z")); got != -Minute {
+		t.Errorf("Until() = %s, expected -PT1M", got)
+	}
+	if SystemClock.Until(Now()+Timestamp(Hour)) <= 0 {
+		t.Error("SystemClock.Until() of a future instant is not positive")
+	}
+}
+
+func TestSleepUntil(t *testing.T) {
+	target := Now() + Timestamp(20*Millisecond)
+	if err := SleepUntil(context.Background(), target); err != nil {
+		t.Fatalf("SleepUntil() = %v", err)
+	}
+	if Now() < target {
+		t.Error("SleepUntil() returned early")
+	}
+
+	if err := SleepUntil(context.Background(), 0); err != nil {
+		t.Errorf("SleepUntil() of a past instant = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := SleepUntil(ctx, Now()+Timestamp(Hour)); err != context.DeadlineExceeded {
+		t.Errorf("SleepUntil() with expiring context = %v", err)
+	}
+}
+
+func TestSleepUntilClockManual(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	c := NewManualClock(start)
+
+	done := make(chan error, 1)
+	go func() { done <- SleepUntilClock(context.Background(), c, start+Timestamp(Minute)) }()
+
+	c.Advance(30 * Second)
+	select {
+	case err := <-done:
+		t.Fatalf("SleepUntilClock() returned %v before the clock reached ts", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	c.Advance(30 * Second)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("SleepUntilClock() = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("SleepUntilClock() did not return once the clock reached ts")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := SleepUntilClock(ctx, c, c.Now()+Timestamp(Hour)); err != context.Canceled {
+		t.Errorf("SleepUntilClock() with cancelled context = %v", err)
+	}
+}
+
+func TestManualClockAfterFunc(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	c := NewManualClock(start)
+
+	var order []int
+	c.afterFunc(start+Timestamp(2*Second), func() { order = append(order, 2) })
+	c.afterFunc(start+Timestamp(Second), func() { order = append(order, 1) })
+	cancel := c.afterFunc(start+Timestamp(3*Second), func() { order = append(order, 3) })
+
+	c.Advance(500 * Millisecond)
+	if len(order) != 0 {
+		t.Errorf("callbacks ran early: %v", order)
+	}
+	if !cancel() {
+		t.Error("cancel() = false, expected pending callback")
+	}
+	c.Advance(5 * Second)
+	if len(order) != 2 || order[0] != 1 || order[1] != 2 {
+		t.Errorf("callbacks ran as %v, expected [1 2]", order)
+	}
+	if cancel() {
+		t.Error("second cancel() = true, expected false")
+	}
+}
@@ -0,0 +1,77 @@
+package universal_timestamp
+
+/*
+#include "universal_timestamp.h"
+*/
+import "C"
+
+import "strconv"
+
+// ClockSource selects the kernel clock read by a Clock from NewClock.
+type ClockSource int
+
+const (
+	// ClockRealtime is the wall clock, counted from the Unix epoch. It can
+	// jump when the system time is set.
+	ClockRealtime ClockSource = C.UT_CLOCK_REALTIME
+	// ClockMonotonic never goes backwards but may be slewed by NTP.
+	ClockMonotonic ClockSource = C.UT_CLOCK_MONOTONIC
+	// ClockMonotonicRaw is the hardware clock without NTP adjustment.
+	ClockMonotonicRaw ClockSource = C.UT_CLOCK_MONOTONIC_RAW
+	// ClockBoottime is like ClockMonotonic but keeps counting while the
+	// system is suspended.
+	ClockBoottime ClockSource = C.UT_CLOCK_BOOTTIME
+)
+
+// String returns the name of the clock source.
+func (s ClockSource) String() string {
+	switch s {
+	case ClockRealtime:
+		return "REALTIME"
+	case ClockMonotonic:
+		return "MONOTONIC"
+	case ClockMonotonicRaw:
+		return "MONOTONIC_RAW"
+	case ClockBoottime:
+		return "BOOTTIME"
+	}
+	return "ClockSource(" + strconv.Itoa(int(s)) + ")"
+}
+
+// NewClock returns a Clock that reads source. Readings from sources other
+// than ClockRealtime count from an unspecified point, so they are only
+// meaningful relative to other readings of the same source; use them for
+// latency measurement, not for formatting. It returns ErrUnsupportedClock
+// if the source is not available on this platform.
+func NewClock(source ClockSource) (Clock, error) {
+	if source == ClockRealtime {
+		return SystemClock, nil
+	}
+	if _, err := readClockSource(source); err != nil {
+		return nil, err
+	}
+	return sourceClock(source), nil
+}
+
+// sourceClock reads a non-realtime clock through the C core.
+type sourceClock ClockSource
+
+// Now returns the current reading of the clock source.
+func (c sourceClock) Now() Timestamp {
+	ts, _ := readClockSource(ClockSource(c))
+	return ts
+}
+
+// Until returns the duration from the clock's current reading until ts.
+func (c sourceClock) Until(ts Timestamp) Duration {
+	return Duration(ts - c.Now())
+}
+
+func readClockSource(source ClockSource) (Timestamp, error) {
+	var out C.ut_timestamp_t
+	observeCgo("ut_now_source")
+	if C.ut_now_source(C.ut_clock_source_t(source), &out) != C.UT_OK {
+		return 0, ErrUnsupportedClock
+	}
+	return Timestamp(out.nanos), nil
+}
@@ -0,0 +1,46 @@
+package universal_timestamp
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestNewClock(t *testing.T) {
+	rt, err := NewClock(ClockRealtime)
+	if err != nil || rt != SystemClock {
+		t.Fatalf("NewClock(ClockRealtime) = %v, %v", rt, err)
+	}
+
+	mono, err := NewClock(ClockMonotonic)
+	if err != nil {
+		t.Fatalf("NewClock(ClockMonotonic) failed: %v", err)
+	}
+	a := mono.Now()
+	b := mono.Now()
+	if b < a {
+		t.Errorf("monotonic clock went backwards: %d then %d", int64(a), int64(b))
+	}
+
+	for _, src := range []ClockSource{ClockMonotonicRaw, ClockBoottime} {
+		c, err := NewClock(src)
+		if runtime.GOOS == "linux" && err != nil {
+			t.Errorf("NewClock(%s) failed on linux: %v", src, err)
+		}
+		if err == nil && c.Now() == 0 {
+			t.Errorf("NewClock(%s).Now() returned 0", src)
+		}
+	}
+
+	if _, err := NewClock(ClockSource(99)); err != ErrUnsupportedClock {
+		t.Errorf("NewClock(99) = %v, expected ErrUnsupportedClock", err)
+	}
+}
+
+func TestClockSourceString(t *testing.T) {
+	if s := ClockMonotonicRaw.String(); s != "MONOTONIC_RAW" {
+		t.Errorf("String() = %s", s)
+	}
+	if s := ClockSource(99).String(); s != "ClockSource(99)" {
+		t.Errorf("String() = %s", s)
+	}
+}
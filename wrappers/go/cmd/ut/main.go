@@ -0,0 +1,285 @@
+// Command ut converts, validates and does arithmetic on Universal
+// Timestamps. It doubles as a worked example of the Go wrapper.
+//
+// Usage:
+//
+//	ut now [output flags]
+//	ut parse [-lenient] [output flags] VALUE
+//	ut add [-lenient] [output flags] VALUE DURATION
+//	ut diff [-lenient] [-human] A B
+//	ut validate [-lenient] VALUE...
+//	ut formats
+//
+// VALUE is an ISO-8601 timestamp, or "@" followed by decimal seconds since
+// the Unix epoch ("@1734177600.5"). DURATION is an ISO-8601 duration such
+// as "PT1H30M" or "-P1D". The output flags are -s, -ms, -us and -ns for
+// integer epoch counts, -zone NAME to render in an IANA zone, and
+// -strftime LAYOUT for a custom layout.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, uts.DefaultClock()))
+}
+
+// errUsage reports a command line that does not match any command.
+var errUsage = errors.New("usage: ut now|parse|add|diff|validate|formats [flags] [args]")
+
+// run executes the command in args and returns the process exit status.
+func run(args []string, stdout, stderr io.Writer, clock uts.Clock) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, errUsage)
+		return 2
+	}
+
+	var err error
+	switch args[0] {
+	case "now":
+		err = cmdNow(args[1:], stdout, stderr, clock)
+	case "parse":
+		err = cmdParse(args[1:], stdout, stderr)
+	case "add":
+		err = cmdAdd(args[1:], stdout, stderr)
+	case "diff":
+		err = cmdDiff(args[1:], stdout, stderr)
+	case "validate":
+		err = cmdValidate(args[1:], stdout, stderr)
+	case "formats":
+		err = cmdFormats(stdout)
+	default:
+		err = errUsage
+	}
+
+	switch {
+	case err == nil || errors.Is(err, flag.ErrHelp):
+		// The flag set has already printed the usage for -h.
+		return 0
+	case errors.Is(err, errUsage):
+		fmt.Fprintln(stderr, err)
+		return 2
+	default:
+		fmt.Fprintln(stderr, "ut:", err)
+		return 1
+	}
+}
+
+// output holds the flags selecting how a timestamp is printed.
+type output struct {
+	s, ms, us, ns bool
+	zone          string
+	strftime      string
+}
+
+func (o *output) register(fs *flag.FlagSet) {
+	fs.BoolVar(&o.s, "s", false, "print whole seconds since the Unix epoch")
+	fs.BoolVar(&o.ms, "ms", false, "print milliseconds since the Unix epoch")
+	fs.BoolVar(&o.us, "us", false, "print microseconds since the Unix epoch")
+	fs.BoolVar(&o.ns, "ns", false, "print nanoseconds since the Unix epoch")
+	fs.StringVar(&o.zone, "zone", "", "render in the named IANA zone")
+	fs.StringVar(&o.strftime, "strftime", "", "render with a strftime layout")
+}
+
+// format renders ts according to the selected flags.
+func (o *output) format(ts uts.Timestamp) (string, error) {
+	switch {
+	case o.s:
+		return fmt.Sprint(int64(ts.EpochSeconds())), nil
+	case o.ms:
+		return fmt.Sprint(int64(ts.EpochMillis())), nil
+	case o.us:
+		return fmt.Sprint(int64(ts.EpochMicros())), nil
+	case o.ns:
+		return fmt.Sprint(int64(ts)), nil
+	}
+
+	var loc *time.Location
+	if o.zone != "" {
+		l, err := time.LoadLocation(o.zone)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s", uts.ErrUnknownZone, o.zone)
+		}
+		loc = l
+	}
+	switch {
+	case o.strftime != "":
+		return ts.Strftime(o.strftime, loc), nil
+	case loc != nil:
+		return uts.NewZoned(ts, loc).Format(), nil
+	}
+	return ts.Format(), nil
+}
+
+// newFlagSet returns a flag set for a subcommand that reports errors
+// instead of exiting, writing usage and flag errors to stderr.
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("ut "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	return fs
+}
+
+// parseValue reads a timestamp argument.
+func parseValue(s string, lenient bool) (uts.Timestamp, error) {
+	var ts uts.Timestamp
+	var err error
+	if strings.HasPrefix(s, "@") {
+		ts, err = uts.ParseEpochDecimal(s[1:])
+	} else {
+		codec := uts.Codec{Config: uts.Config{Lenient: lenient}}
+		ts, err = codec.Parse(s)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%q: %w", s, err)
+	}
+	return ts, nil
+}
+
+func cmdNow(args []string, stdout, stderr io.Writer, clock uts.Clock) error {
+	var out output
+	fs := newFlagSet("now", stderr)
+	out.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+	if fs.NArg() != 0 {
+		return errUsage
+	}
+	s, err := out.format(clock.Now())
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, s)
+	return nil
+}
+
+func cmdParse(args []string, stdout, stderr io.Writer) error {
+	var out output
+	fs := newFlagSet("parse", stderr)
+	lenient := fs.Bool("lenient", false, "accept lenient input forms")
+	out.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+	if fs.NArg() != 1 {
+		return errUsage
+	}
+	ts, err := parseValue(fs.Arg(0), *lenient)
+	if err != nil {
+		return err
+	}
+	s, err := out.format(ts)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, s)
+	return nil
+}
+
+func cmdAdd(args []string, stdout, stderr io.Writer) error {
+	var out output
+	fs := newFlagSet("add", stderr)
+	lenient := fs.Bool("lenient", false, "accept lenient input forms")
+	out.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+	if fs.NArg() != 2 {
+		return errUsage
+	}
+	ts, err := parseValue(fs.Arg(0), *lenient)
+	if err != nil {
+		return err
+	}
+
+	p, d, err := uts.ParseISODuration(fs.Arg(1))
+	if err != nil {
+		return fmt.Errorf("%q: %w", fs.Arg(1), err)
+	}
+
+	var loc *time.Location
+	if out.zone != "" {
+		if loc, err = time.LoadLocation(out.zone); err != nil {
+			return fmt.Errorf("%w: %s", uts.ErrUnknownZone, out.zone)
+		}
+	}
+	s, err := out.format(ts.AddPeriod(p, loc) + uts.Timestamp(d))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, s)
+	return nil
+}
+
+func cmdDiff(args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("diff", stderr)
+	lenient := fs.Bool("lenient", false, "accept lenient input forms")
+	human := fs.Bool("human", false, "print a humanized duration")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+	if fs.NArg() != 2 {
+		return errUsage
+	}
+	a, err := parseValue(fs.Arg(0), *lenient)
+	if err != nil {
+		return err
+	}
+	b, err := parseValue(fs.Arg(1), *lenient)
+	if err != nil {
+		return err
+	}
+
+	d := uts.Duration(b - a)
+	if *human {
+		fmt.Fprintln(stdout, d.Humanize())
+	} else {
+		fmt.Fprintln(stdout, d)
+	}
+	return nil
+}
+
+func cmdValidate(args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("validate", stderr)
+	lenient := fs.Bool("lenient", false, "accept lenient input forms")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+	if fs.NArg() == 0 {
+		return errUsage
+	}
+
+	invalid := 0
+	for _, s := range fs.Args() {
+		if _, err := parseValue(s, *lenient); err != nil {
+			fmt.Fprintf(stdout, "invalid\t%s\t%v\n", s, errors.Unwrap(err))
+			invalid++
+			continue
+		}
+		fmt.Fprintf(stdout, "ok\t%s\n", s)
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d inputs invalid", invalid, fs.NArg())
+	}
+	return nil
+}
+
+func cmdFormats(stdout io.Writer) error {
+	for _, f := range uts.SupportedFormats() {
+		requires := "strict"
+		if !f.Strict {
+			requires = f.Requires
+		}
+		fmt.Fprintf(stdout, "%-32s %-36s %s\n", f.Name, f.Example, requires)
+	}
+	return nil
+}
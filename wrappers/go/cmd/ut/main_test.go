@@ -0,0 +1,100 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	uts "github.com/mozrin/universal_timestamp"
+)
+
+func runUT(t *testing.T, args ...string) (string, string, int) {
+	t.Helper()
+	now, _ := uts.Parse("2024-12-14T12:00:00.123456789Z")
+	var stdout, stderr strings.Builder
+	code := run(args, &stdout, &stderr, uts.NewManualClock(now))
+	return strings.TrimSpace(stdout.String()), stderr.String(), code
+}
+
+func TestCommands(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"now"}, "2024-12-14T12:00:00.123456789Z"},
+		{[]string{"now", "--ms"}, "1734177600123"},
+		{[]string{"now", "-s"}, "1734177600"},
+		{[]string{"parse", "@1734177600.5"}, "2024-12-14T12:00:00.5Z"},
+		{[]string{"parse", "-us", "2024-12-14T12:00:00.123456Z"}, "1734177600123456"},
+		{[]string{"parse", "-strftime", "%d/%m/%Y", "2024-12-14T12:00:00Z"}, "14/12/2024"},
+		{[]string{"parse", "-lenient", "2024-12-14T12:00:00+00:00"}, "2024-12-14T12:00:00Z"},
+		{[]string{"add", "2024-12-14T12:00:00Z", "P1DT1H30M"}, "2024-12-15T13:30:00Z"},
+		{[]string{"add", "2024-03-31T00:00:00Z", "-P1M"}, "2024-02-29T00:00:00Z"},
+		{[]string{"add", "2024-12-14T12:00:00Z", "PT90S"}, "2024-12-14T12:01:30Z"},
+		{[]string{"diff", "2024-12-14T12:00:00Z", "2024-12-14T13:30:00Z"}, "PT1H30M"},
+		{[]string{"diff", "-human", "2024-12-14T13:30:00Z", "2024-12-14T12:00:00Z"}, "-1h 30m"},
+	}
+	for _, tt := range tests {
+		out, stderr, code := runUT(t, tt.args...)
+		if code != 0 {
+			t.Errorf("ut %s exited %d: %s", strings.Join(tt.args, " "), code, stderr)
+			continue
+		}
+		if out != tt.expected {
+			t.Errorf("ut %s = %q, expected %q", strings.Join(tt.args, " "), out, tt.expected)
+		}
+	}
+}
+
+func TestParseZone(t *testing.T) {
+	out, stderr, code := runUT(t, "parse", "-zone", "Europe/Paris", "2024-12-14T12:00:00Z")
+	if code != 0 {
+		if strings.Contains(stderr, "unknown time zone") {
+			t.Skip("tzdata not available")
+		}
+		t.Fatalf("exit %d: %s", code, stderr)
+	}
+	if out != "2024-12-14T13:00:00+01:00[Europe/Paris]" {
+		t.Errorf("ut parse -zone = %q", out)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	out, _, code := runUT(t, "validate", "2024-12-14T12:00:00Z", "2024-13-01T00:00:00Z")
+	if code != 1 {
+		t.Errorf("validate exit = %d, expected 1", code)
+	}
+	lines := strings.Split(out, "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "ok\t") || !strings.HasPrefix(lines[1], "invalid\t") {
+		t.Errorf("validate output = %q", out)
+	}
+
+	if _, _, code := runUT(t, "validate", "2024-12-14T12:00:00Z"); code != 0 {
+		t.Errorf("validate of valid input exit = %d, expected 0", code)
+	}
+}
+
+func TestUsageErrors(t *testing.T) {
+	for _, args := range [][]string{
+		nil,
+		{"bogus"},
+		{"parse"},
+		{"diff", "2024-12-14T12:00:00Z"},
+		{"now", "-nope"},
+	} {
+		if _, _, code := runUT(t, args...); code != 2 {
+			t.Errorf("ut %v exit = %d, expected 2", args, code)
+		}
+	}
+	if _, stderr, code := runUT(t, "add", "2024-12-14T12:00:00Z", "P"); code != 1 || stderr == "" {
+		t.Errorf("invalid duration exit = %d, stderr %q", code, stderr)
+	}
+	if _, _, code := runUT(t, "formats"); code != 0 {
+		t.Errorf("formats exit = %d", code)
+	}
+	if _, stderr, code := runUT(t, "parse", "-h"); code != 0 || !strings.Contains(stderr, "-lenient") {
+		t.Errorf("parse -h exit = %d, stderr %q", code, stderr)
+	}
+	if _, stderr, code := runUT(t, "now", "-nope"); !strings.Contains(stderr, "-nope") {
+		t.Errorf("now -nope exit = %d, stderr %q", code, stderr)
+	}
+}
@@ -0,0 +1,107 @@
+package universal_timestamp
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultCoarseGranularity is the refresh interval used by NowCoarse until
+// SetCoarseGranularity is called.
+const DefaultCoarseGranularity = Millisecond
+
+var (
+	coarseNow         atomic.Int64
+	coarseGranularity atomic.Int64
+	coarseRunning     atomic.Bool
+
+	// coarseMu guards starting and stopping the refresh goroutine.
+	coarseMu   sync.Mutex
+	coarseStop chan struct{}
+)
+
+// NowCoarse returns a cached current time that is refreshed in the
+// background every coarse granularity. It costs two atomic loads, so it
+// suits per-packet or per-event timestamps where Now's cgo call is too
+// expensive and lagging by up to one granularity is acceptable. The first
+// call starts the refresh goroutine, which runs until Close stops it; a
+// later call starts it again.
+func NowCoarse() Timestamp {
+	if !coarseRunning.Load() {
+		startCoarseClock()
+	}
+	return Timestamp(coarseNow.Load())
+}
+
+// SetCoarseGranularity changes how often NowCoarse's cached value is
+// refreshed. A non-positive d restores DefaultCoarseGranularity. The new
+// granularity takes effect after the next refresh.
+func SetCoarseGranularity(d Duration) {
+	if d <= 0 {
+		d = DefaultCoarseGranularity
+	}
+	coarseGranularity.Store(int64(d))
+}
+
+// CoarseGranularity returns the current refresh interval of NowCoarse.
+func CoarseGranularity() Duration {
+	if d := Duration(coarseGranularity.Load()); d > 0 {
+		return d
+	}
+	return DefaultCoarseGranularity
+}
+
+// startCoarseClock starts the refresh goroutine unless it is running.
+func startCoarseClock() {
+	coarseMu.Lock()
+	defer coarseMu.Unlock()
+	if coarseRunning.Load() {
+		return
+	}
+	coarseNow.Store(int64(Now()))
+	stop := make(chan struct{})
+	coarseStop = stop
+	coarseRunning.Store(true)
+
+	go func() {
+		timer := time.NewTimer(CoarseGranularity().Std())
+		defer timer.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-timer.C:
+			}
+			coarseNow.Store(int64(Now()))
+			timer.Reset(CoarseGranularity().Std())
+		}
+	}()
+}
+
+// stopCoarseClock stops the refresh goroutine if it is running.
+func stopCoarseClock() {
+	coarseMu.Lock()
+	defer coarseMu.Unlock()
+	if !coarseRunning.Load() {
+		return
+	}
+	close(coarseStop)
+	coarseStop = nil
+	coarseRunning.Store(false)
+}
+
+// coarseClock reads the cached time maintained for NowCoarse.
+type coarseClock struct{}
+
+// Now returns NowCoarse().
+func (coarseClock) Now() Timestamp {
+	return NowCoarse()
+}
+
+// Until returns the duration from NowCoarse() until ts.
+func (coarseClock) Until(ts Timestamp) Duration {
+	return Duration(ts - NowCoarse())
+}
+
+// CoarseClock is the Clock backed by NowCoarse.
+var CoarseClock Clock = coarseClock{}
@@ -0,0 +1,56 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNowCoarse(t *testing.T) {
+	before := Now()
+	got := NowCoarse()
+	if got < before-Timestamp(Second) || got > Now() {
+		t.Errorf("NowCoarse() = %s, expected close to %s", got.Format(), before.Format())
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for NowCoarse() == got {
+		if time.Now().After(deadline) {
+			t.Fatal("NowCoarse() was not refreshed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if CoarseClock.Now() == 0 {
+		t.Error("CoarseClock.Now() returned 0")
+	}
+}
+
+func TestSetCoarseGranularity(t *testing.T) {
+	defer SetCoarseGranularity(0)
+
+	SetCoarseGranularity(5 * Millisecond)
+	if got := CoarseGranularity(); got != 5*Millisecond {
+		t.Errorf("CoarseGranularity() = %s, expected PT0.005S", got)
+	}
+	SetCoarseGranularity(-1)
+	if got := CoarseGranularity(); got != DefaultCoarseGranularity {
+		t.Errorf("CoarseGranularity() = %s, expected default", got)
+	}
+}
+
+func TestNowCoarseRestartsAfterClose(t *testing.T) {
+	NowCoarse()
+	Close()
+	if coarseRunning.Load() {
+		t.Fatal("refresh goroutine still running after Close")
+	}
+	Close()
+
+	before := Now()
+	if got := NowCoarse(); got < before {
+		t.Errorf("NowCoarse() after Close = %s, expected at least %s", got.Format(), before.Format())
+	}
+	if !coarseRunning.Load() {
+		t.Error("NowCoarse() did not restart the refresh goroutine")
+	}
+}
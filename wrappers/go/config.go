@@ -0,0 +1,191 @@
+package universal_timestamp
+
+import (
+	"sync/atomic"
+)
+
+// Special values for Config.Precision.
+const (
+	// PrecisionCanonical prints fractional seconds only when non-zero and
+	// omits trailing zeros. It is the zero value.
+	PrecisionCanonical = 0
+	// PrecisionSeconds omits fractional seconds entirely.
+	PrecisionSeconds = -1
+)
+
+// OffsetStyle selects how the UTC offset is written by Format.
+type OffsetStyle int
+
+const (
+	// OffsetZ writes the UTC designator "Z". It is the zero value.
+	OffsetZ OffsetStyle = iota
+	// OffsetNumeric writes a numeric "+00:00" offset. Such strings are
+	// only accepted back by lenient parsing.
+	OffsetNumeric
+)
+
+// Config controls how timestamps are formatted and parsed. The zero value
+// matches the canonical UTS behaviour: strict parsing and "Z"-suffixed
+// output with minimal fractional digits.
+type Config struct {
+	// Precision is the number of fractional-second digits (1-9) to print,
+	// or PrecisionCanonical or PrecisionSeconds.
+	Precision int
+	// OffsetStyle selects how the UTC offset is written.
+	OffsetStyle OffsetStyle
+	// Lenient enables the C core's lenient parsing mode.
+	Lenient bool
+	// Rounding selects how Format reduces precision when Precision limits
+	// the fractional digits. The package defaults' Rounding also applies
+	// to FormatPrecision and to the EpochSeconds, EpochMillis and
+	// EpochMicros conversions. The zero value, RoundFloor, truncates.
+	Rounding RoundingMode
+}
+
+// Codec formats and parses timestamps according to its Config.
+type Codec struct {
+	Config Config
+}
+
+// defaults holds the Config used by the package-level Parse and Format.
+var defaults atomic.Pointer[Codec]
+
+func init() {
+	defaults.Store(&Codec{})
+}
+
+// SetDefaults replaces the configuration used by Parse, Timestamp.Format
+// and Timestamp.AppendFormat. It is safe to call concurrently with them.
+func SetDefaults(cfg Config) {
+	defaults.Store(&Codec{Config: cfg})
+}
+
+// Defaults returns the configuration currently used by Parse and Format.
+func Defaults() Config {
+	return defaults.Load().Config
+}
+
+// defaultCodec returns the Codec holding the package defaults.
+func defaultCodec() *Codec {
+	return defaults.Load()
+}
+
+// NewCodec returns a Codec using cfg.
+func NewCodec(cfg Config) *Codec {
+	return &Codec{Config: cfg}
+}
+
+// Parse parses an ISO-8601 string into a timestamp. opts relax individual
+// rules for this call only.
+func (c *Codec) Parse(s string, opts ...ParseOption) (Timestamp, error) {
+	if len(opts) == 0 {
+		return parseC(s, !c.Config.Lenient)
+	}
+	return parseWithOptions(s, !c.Config.Lenient, opts)
+}
+
+// ParseWithOffset parses an ISO-8601 string that may carry a numeric UTC
+// offset such as "+05:30" and returns the instant together with that
+// offset in seconds east of UTC. A "Z" suffix reports an offset of zero.
+func (c *Codec) ParseWithOffset(s string, opts ...ParseOption) (Timestamp, int, error) {
+	cfg := parseConfig{maxFraction: 9, offsets: true}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return parseConfigured(s, !c.Config.Lenient, cfg)
+}
+
+// Format formats ts as an ISO-8601 string.
+func (c *Codec) Format(ts Timestamp) string {
+	var buf [40]byte
+	b := c.AppendFormat(buf[:0], ts)
+	return string(b)
+}
+
+// FormatChecked is like Format but returns ErrBufferTooSmall if the C core
+// cannot render ts.
+func (c *Codec) FormatChecked(ts Timestamp) (string, error) {
+	var buf [40]byte
+	b, err := c.AppendFormatChecked(buf[:0], ts)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// FormatLen returns the length of Format(ts) without formatting, so callers
+// formatting many values can size a buffer exactly.
+func (c *Codec) FormatLen(ts Timestamp) int {
+	ts = c.round(ts)
+	var n int
+	switch p := c.Config.Precision; {
+	case p == PrecisionCanonical:
+		n = formatLenC(ts, true)
+	case p < 0:
+		n = formatLenC(ts, false)
+	default:
+		if p > 9 {
+			p = 9
+		}
+		n = formatLenC(ts, false)
+		if p > 0 {
+			n += 1 + p
+		}
+	}
+
+	if c.Config.OffsetStyle == OffsetNumeric {
+		n += len("+00:00") - len("Z")
+	}
+	return n
+}
+
+// AppendFormat appends the ISO-8601 form of ts to dst and returns the
+// extended buffer.
+func (c *Codec) AppendFormat(dst []byte, ts Timestamp) []byte {
+	b, _ := c.appendFormat(dst, ts, false)
+	return b
+}
+
+// AppendFormatChecked is like AppendFormat but returns ErrBufferTooSmall,
+// and dst unchanged, if the C core cannot render ts.
+func (c *Codec) AppendFormatChecked(dst []byte, ts Timestamp) ([]byte, error) {
+	return c.appendFormat(dst, ts, true)
+}
+
+// appendFormat implements AppendFormat and, in strict mode,
+// AppendFormatChecked.
+func (c *Codec) appendFormat(dst []byte, ts Timestamp, strict bool) ([]byte, error) {
+	start := len(dst)
+	ts = c.round(ts)
+	var err error
+	switch p := c.Config.Precision; {
+	case p == PrecisionCanonical:
+		dst, err = appendFormatCMode(dst, ts, true, strict)
+	case p < 0:
+		dst, err = appendFormatCMode(dst, ts, false, strict)
+	case p > 9:
+		dst, err = appendFixedPrecisionMode(dst, ts, 9, strict)
+	default:
+		dst, err = appendFixedPrecisionMode(dst, ts, p, strict)
+	}
+	if err != nil {
+		return dst[:start], err
+	}
+
+	if c.Config.OffsetStyle == OffsetNumeric && dst[len(dst)-1] == 'Z' {
+		dst = append(dst[:len(dst)-1], "+00:00"...)
+	}
+	return dst, nil
+}
+
+// round applies the Codec's rounding mode for its precision to ts.
+func (c *Codec) round(ts Timestamp) Timestamp {
+	digits := c.Config.Precision
+	if digits == PrecisionCanonical {
+		return ts
+	}
+	if digits < 0 {
+		digits = 0
+	}
+	return roundDigits(ts, digits, c.Config.Rounding)
+}
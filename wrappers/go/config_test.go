@@ -0,0 +1,69 @@
+package universal_timestamp
+
+import "testing"
+
+func TestCodec(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00.5Z")
+
+	cases := []struct {
+		cfg      Config
+		expected string
+	}{
+		{Config{}, "2024-12-14T12:00:00.5Z"},
+		{Config{Precision: PrecisionSeconds}, "2024-12-14T12:00:00Z"},
+		{Config{Precision: 3}, "2024-12-14T12:00:00.500Z"},
+		{Config{OffsetStyle: OffsetNumeric}, "2024-12-14T12:00:00.5+00:00"},
+	}
+	for _, c := range cases {
+		if got := NewCodec(c.cfg).Format(ts); got != c.expected {
+			t.Errorf("Format(%+v) = %s, expected %s", c.cfg, got, c.expected)
+		}
+	}
+
+	if _, err := NewCodec(Config{}).Parse("2024-12-14T12:00:00"); err == nil {
+		t.Error("strict codec accepted a missing Z")
+	}
+	if _, err := NewCodec(Config{Lenient: true}).Parse("2024-12-14T12:00:00"); err != nil {
+		t.Errorf("lenient codec rejected a missing Z: %v", err)
+	}
+}
+
+func TestSetDefaults(t *testing.T) {
+	defer SetDefaults(Config{})
+
+	SetDefaults(Config{Precision: 3, Lenient: true})
+	if got := Defaults(); got.Precision != 3 || !got.Lenient {
+		t.Errorf("Defaults() = %+v", got)
+	}
+
+	ts, err := Parse("2024-12-14T12:00:00+00:00")
+	if err != nil {
+		t.Fatalf("lenient default Parse failed: %v", err)
+	}
+	if got := ts.Format(); got != "2024-12-14T12:00:00.000Z" {
+		t.Errorf("Format() with default precision 3 = %s", got)
+	}
+}
+
+func TestCodecFormatLen(t *testing.T) {
+	configs := []Config{
+		{},
+		{Precision: PrecisionSeconds},
+		{Precision: 3},
+		{Precision: 12},
+		{OffsetStyle: OffsetNumeric},
+		{Precision: 6, OffsetStyle: OffsetNumeric},
+	}
+	values := []Timestamp{0, -1, mustParse(t, "2024-12-14T12:00:00.5Z"), mustParse(t, "2024-12-14T12:00:00.123456789Z")}
+	for _, cfg := range configs {
+		c := NewCodec(cfg)
+		for _, ts := range values {
+			if got, want := c.FormatLen(ts), len(c.Format(ts)); got != want {
+				t.Errorf("FormatLen(%d) with %+v = %d, expected %d", int64(ts), cfg, got, want)
+			}
+		}
+	}
+	if got := mustParse(t, "2024-12-14T12:00:00.5Z").FormatLen(); got != 22 {
+		t.Errorf("FormatLen() = %d, expected 22", got)
+	}
+}
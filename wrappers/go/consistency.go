@@ -0,0 +1,72 @@
+package universal_timestamp
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultClockTolerance is the divergence CheckClockConsistency allows
+// when given a non-positive tolerance.
+const DefaultClockTolerance = 10 * Millisecond
+
+// clockSamples is the number of readings CheckClockConsistency takes,
+// keeping the one least disturbed by scheduling.
+const clockSamples = 5
+
+// ClockReport is the result of comparing the C core's clock with the Go
+// runtime's.
+type ClockReport struct {
+	// Core is the reading of ut_now.
+	Core Timestamp
+	// Runtime is the reading of time.Now at the same moment, estimated as
+	// the midpoint of readings taken just before and after Core.
+	Runtime Timestamp
+	// Delta is Core minus Runtime: positive when the C core is ahead.
+	Delta Duration
+	// Uncertainty is half the time between the surrounding time.Now
+	// readings, which bounds the error in Delta.
+	Uncertainty Duration
+}
+
+// CheckClockConsistency compares the C core's ut_now with the Go runtime's
+// time.Now, so a deployment can detect at startup a C core built against a
+// different or misconfigured clock source. It takes several readings and
+// reports the one with the smallest uncertainty. If the clocks differ by
+// more than tolerance beyond that uncertainty, it returns the report
+// together with ErrClockDivergence. A non-positive tolerance uses
+// DefaultClockTolerance. NowFast makes the same check at startup with a
+// tolerance of one millisecond.
+func CheckClockConsistency(tolerance Duration) (ClockReport, error) {
+	if tolerance <= 0 {
+		tolerance = DefaultClockTolerance
+	}
+
+	var best ClockReport
+	for i := 0; i < clockSamples; i++ {
+		before := time.Now()
+		core := Now()
+		after := time.Now()
+
+		half := Duration(after.Sub(before) / 2)
+		if i > 0 && half >= best.Uncertainty {
+			continue
+		}
+		wall := FromTime(before) + Timestamp(half)
+		best = ClockReport{
+			Core:        core,
+			Runtime:     wall,
+			Delta:       Duration(core - wall),
+			Uncertainty: half,
+		}
+	}
+
+	divergence := best.Delta
+	if divergence < 0 {
+		divergence = -divergence
+	}
+	if divergence > tolerance+best.Uncertainty {
+		return best, fmt.Errorf("%w: C core clock differs from the Go runtime by %s (tolerance %s)",
+			ErrClockDivergence, best.Delta.Std(), tolerance.Std())
+	}
+	return best, nil
+}
@@ -0,0 +1,33 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckClockConsistency(t *testing.T) {
+	report, err := CheckClockConsistency(0)
+	if err != nil {
+		t.Fatalf("CheckClockConsistency() error: %v", err)
+	}
+	if report.Delta != Duration(report.Core-report.Runtime) {
+		t.Errorf("Delta = %d, expected Core-Runtime = %d", report.Delta, report.Core-report.Runtime)
+	}
+	if report.Uncertainty < 0 {
+		t.Errorf("Uncertainty = %d, expected non-negative", report.Uncertainty)
+	}
+}
+
+func TestCheckClockConsistencyDivergence(t *testing.T) {
+	// A tolerance of one nanosecond fails unless the clocks agree exactly
+	// within the measurement uncertainty.
+	report, err := CheckClockConsistency(1)
+	d := report.Delta
+	if d < 0 {
+		d = -d
+	}
+	diverged := d > 1+report.Uncertainty
+	if diverged != errors.Is(err, ErrClockDivergence) {
+		t.Errorf("CheckClockConsistency(1ns) = %+v, %v: error does not match the report", report, err)
+	}
+}
@@ -0,0 +1,55 @@
+package universal_timestamp
+
+import "time"
+
+// StartOfDay returns midnight at the start of the day containing the
+// timestamp, as observed in loc. If midnight does not exist because of a
+// daylight-saving transition, the first instant of the day is returned. A
+// nil loc is treated as UTC.
+func (t Timestamp) StartOfDay(loc *time.Location) Timestamp {
+	y, m, d := t.In(loc).Date()
+	return StartOfDate(y, m, d, loc)
+}
+
+// StartOfDate returns the first instant of the given date in loc: midnight,
+// or the end of the daylight-saving gap on days such as 2015-10-18 in
+// America/Sao_Paulo, whose clocks jumped from 23:59:59 to 01:00. Month and
+// day values outside their usual ranges are normalized as by time.Date. A
+// nil loc is treated as UTC.
+func StartOfDate(year int, month time.Month, day int, loc *time.Location) Timestamp {
+	return FromTime(startOfDate(year, month, day, loc))
+}
+
+// startOfDate is StartOfDate returning a time.Time, for callers that go on
+// to do calendar arithmetic in loc.
+func startOfDate(year int, month time.Month, day int, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	t := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	// time.Date resolves a midnight inside a gap using the offset in force
+	// before it, which lands on the previous day; the requested day then
+	// begins where that offset ends.
+	y, m, d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Date()
+	if ty, tm, td := t.Date(); ty != y || tm != m || td != d {
+		if _, end := t.ZoneBounds(); !end.IsZero() {
+			t = end
+		}
+	}
+	return t
+}
+
+// NanosSinceMidnight returns the time elapsed since the start of the day
+// containing the timestamp in loc. On days with a daylight-saving
+// transition this is elapsed time, not the wall-clock reading. A nil loc is
+// treated as UTC.
+func (t Timestamp) NanosSinceMidnight(loc *time.Location) Duration {
+	return Duration(t - t.StartOfDay(loc))
+}
+
+// FromNanosSinceMidnight is the inverse of NanosSinceMidnight: it returns
+// the instant nanos after the start of the day containing day in loc. A nil
+// loc is treated as UTC.
+func FromNanosSinceMidnight(day Timestamp, nanos Duration, loc *time.Location) Timestamp {
+	return day.StartOfDay(loc) + Timestamp(nanos)
+}
@@ -0,0 +1,72 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNanosSinceMidnight(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T09:30:00.000000123Z")
+	expected := 9*Hour + 30*Minute + 123
+
+	if got := ts.NanosSinceMidnight(nil); got != expected {
+		t.Errorf("NanosSinceMidnight() = %d, expected %d", got, expected)
+	}
+	if got := FromNanosSinceMidnight(ts, expected, nil); got != ts {
+		t.Errorf("FromNanosSinceMidnight() = %s, expected %s", got.Format(), ts.Format())
+	}
+	if got := mustParse(t, "1969-12-31T23:00:00Z").NanosSinceMidnight(nil); got != 23*Hour {
+		t.Errorf("NanosSinceMidnight() before epoch = %s", got)
+	}
+}
+
+func TestNanosSinceMidnightZone(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	open := mustParse(t, "2024-12-14T14:30:00Z")
+	if got := open.NanosSinceMidnight(ny); got != 9*Hour+30*Minute {
+		t.Errorf("NanosSinceMidnight(New_York) = %s, expected PT9H30M", got)
+	}
+	if got := FromNanosSinceMidnight(open, 9*Hour+30*Minute, ny); got != open {
+		t.Errorf("FromNanosSinceMidnight(New_York) = %s", got.Format())
+	}
+
+	// Spring forward: 03:00 local is only two hours after midnight.
+	dst := mustParse(t, "2024-03-10T07:00:00Z")
+	if got := dst.NanosSinceMidnight(ny); got != 2*Hour {
+		t.Errorf("NanosSinceMidnight() across DST = %s, expected PT2H", got)
+	}
+}
+
+func TestStartOfDayMidnightGap(t *testing.T) {
+	sp, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	// On 2015-10-18 Sao Paulo clocks jumped from 00:00 to 01:00 (-02:00),
+	// so the day began at 03:00 UTC.
+	start := mustParse(t, "2015-10-18T03:00:00Z")
+	noon := mustParse(t, "2015-10-18T14:00:00Z")
+	if got := noon.StartOfDay(sp); got != start {
+		t.Errorf("StartOfDay(Sao_Paulo) = %s, expected %s", got.Format(), start.Format())
+	}
+	if got := StartOfDate(2015, time.October, 18, sp); got != start {
+		t.Errorf("StartOfDate(Sao_Paulo) = %s, expected %s", got.Format(), start.Format())
+	}
+	if got := noon.NanosSinceMidnight(sp); got != 11*Hour {
+		t.Errorf("NanosSinceMidnight(Sao_Paulo) = %s, expected PT11H", got)
+	}
+	if got := FromNanosSinceMidnight(noon, 0, sp); got != start {
+		t.Errorf("FromNanosSinceMidnight(Sao_Paulo) = %s, expected %s", got.Format(), start.Format())
+	}
+	if tc, err := FPS25.TimecodeOf(start, sp); err != nil || tc.String() != "00:00:00:00" {
+		t.Errorf("TimecodeOf(Sao_Paulo start) = %s, %v, expected 00:00:00:00", tc, err)
+	}
+	if got := mustParse(t, "2015-10-18T02:59:59Z").StartOfDay(sp).Format(); got != "2015-10-17T03:00:00Z" {
+		t.Errorf("StartOfDay(Sao_Paulo eve) = %s, expected 2015-10-17T03:00:00Z", got)
+	}
+}
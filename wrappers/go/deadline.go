@@ -0,0 +1,44 @@
+package universal_timestamp
+
+import "context"
+
+// Deadline is the instant by which an operation must complete.
+type Deadline Timestamp
+
+// NewDeadline returns a deadline budget from the current time of clock.
+// A nil clock uses DefaultClock.
+func NewDeadline(clock Clock, budget Duration) Deadline {
+	return Deadline(clockOrSystem(clock).Now() + Timestamp(budget))
+}
+
+// DeadlineFromContext returns the deadline of ctx, if it has one.
+func DeadlineFromContext(ctx context.Context) (Deadline, bool) {
+	t, ok := ctx.Deadline()
+	if !ok {
+		return 0, false
+	}
+	return Deadline(FromTime(t)), true
+}
+
+// Timestamp returns the deadline instant.
+func (d Deadline) Timestamp() Timestamp {
+	return Timestamp(d)
+}
+
+// Remaining returns the time left before the deadline according to clock.
+// The result is negative once the deadline has passed. A nil clock uses
+// DefaultClock.
+func (d Deadline) Remaining(clock Clock) Duration {
+	return clockOrSystem(clock).Until(Timestamp(d))
+}
+
+// Expired reports whether the deadline has been reached according to clock.
+// A nil clock uses DefaultClock.
+func (d Deadline) Expired(clock Clock) bool {
+	return d.Remaining(clock) <= 0
+}
+
+// Context returns a copy of parent that is cancelled at the deadline.
+func (d Deadline) Context(parent context.Context) (context.Context, context.CancelFunc) {
+	return context.WithDeadline(parent, Timestamp(d).ToTime())
+}
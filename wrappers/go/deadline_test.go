@@ -0,0 +1,43 @@
+package universal_timestamp
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDeadline(t *testing.T) {
+	clock := NewManualClock(mustParse(t, "2024-12-14T12:00:00Z"))
+	d := NewDeadline(clock, 5*Second)
+
+	if got := d.Remaining(clock); got != 5*Second {
+		t.Errorf("Remaining() = %d, expected 5s", got)
+	}
+	if d.Expired(clock) {
+		t.Error("Expired() = true before deadline")
+	}
+
+	clock.Advance(5 * Second)
+	if !d.Expired(clock) {
+		t.Error("Expired() = false at deadline")
+	}
+
+	clock.Advance(Second)
+	if got := d.Remaining(clock); got != -Second {
+		t.Errorf("Remaining() after deadline = %d, expected -1s", got)
+	}
+}
+
+func TestDeadlineContext(t *testing.T) {
+	d := Deadline(mustParse(t, "2099-01-01T00:00:00Z"))
+	ctx, cancel := d.Context(context.Background())
+	defer cancel()
+
+	got, ok := DeadlineFromContext(ctx)
+	if !ok || got != d {
+		t.Errorf("DeadlineFromContext() = %d, %v", int64(got), ok)
+	}
+
+	if _, ok := DeadlineFromContext(context.Background()); ok {
+		t.Error("DeadlineFromContext() reported a deadline for Background")
+	}
+}
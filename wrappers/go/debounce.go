@@ -0,0 +1,135 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"sync"
+)
+
+// Debouncer runs a function once calls to Trigger have stopped for a quiet
+// period, coalescing a burst of events into a single action. Deadlines are
+// measured on a Clock; with a ManualClock the function runs during the
+// Advance call that ends the quiet period. It is safe for concurrent use.
+type Debouncer struct {
+	mu     sync.Mutex
+	clock  Clock
+	quiet  Duration
+	f      func()
+	cancel func() bool
+	gen    uint64
+}
+
+// NewDebouncer returns a Debouncer that runs f once quiet has elapsed
+// since the last Trigger. A nil clock uses DefaultClock.
+func NewDebouncer(clock Clock, quiet Duration, f func()) (*Debouncer, error) {
+	if quiet <= 0 {
+		return nil, errors.New("debounce quiet period must be positive")
+	}
+	return &Debouncer{clock: clockOrSystem(clock), quiet: quiet, f: f}, nil
+}
+
+// Trigger records an event, restarting the quiet period.
+func (d *Debouncer) Trigger() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.cancel != nil {
+		d.cancel()
+	}
+	d.gen++
+	gen := d.gen
+	d.cancel = clockAfterFunc(d.clock, d.clock.Now()+Timestamp(d.quiet), func() {
+		// A real-time callback can race with a later Trigger or Stop; only
+		// the most recently scheduled one may run.
+		d.mu.Lock()
+		if d.cancel == nil || d.gen != gen {
+			d.mu.Unlock()
+			return
+		}
+		d.cancel = nil
+		d.mu.Unlock()
+		d.f()
+	})
+}
+
+// Pending reports whether a call to the function is scheduled.
+func (d *Debouncer) Pending() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.cancel != nil
+}
+
+// Flush runs the function immediately if a call is pending, and reports
+// whether it did.
+func (d *Debouncer) Flush() bool {
+	if !d.Stop() {
+		return false
+	}
+	d.f()
+	return true
+}
+
+// Stop cancels a pending call, reporting whether one was pending.
+func (d *Debouncer) Stop() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.cancel == nil {
+		return false
+	}
+	d.cancel()
+	d.cancel = nil
+	return true
+}
+
+// Throttler admits at most one event per window, measured on a Clock. The
+// first event opens the window; events inside it are refused. It is safe
+// for concurrent use.
+type Throttler struct {
+	mu     sync.Mutex
+	clock  Clock
+	window Duration
+	next   Timestamp
+	primed bool
+}
+
+// NewThrottler returns a Throttler admitting one event per window. A nil
+// clock uses DefaultClock.
+func NewThrottler(clock Clock, window Duration) (*Throttler, error) {
+	if window <= 0 {
+		return nil, errors.New("throttle window must be positive")
+	}
+	return &Throttler{clock: clockOrSystem(clock), window: window}, nil
+}
+
+// Allow reports whether an event may happen now, opening a new window if
+// so.
+func (t *Throttler) Allow() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock.Now()
+	if t.primed && now < t.next {
+		return false
+	}
+	t.primed = true
+	t.next = now + Timestamp(t.window)
+	return true
+}
+
+// Do runs f if Allow admits the event, and reports whether it ran.
+func (t *Throttler) Do(f func()) bool {
+	if !t.Allow() {
+		return false
+	}
+	f()
+	return true
+}
+
+// Next returns the earliest instant at which Allow will admit an event.
+func (t *Throttler) Next() Timestamp {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.primed {
+		return t.clock.Now()
+	}
+	return t.next
+}
@@ -0,0 +1,104 @@
+package universal_timestamp
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDebouncer(t *testing.T) {
+	clock := NewManualClock(mustParse(t, "2024-12-14T12:00:00Z"))
+	var calls int
+	d, err := NewDebouncer(clock, Second, func() { calls++ })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.Trigger()
+	clock.Advance(600 * Millisecond)
+	d.Trigger()
+	clock.Advance(600 * Millisecond)
+	if calls != 0 {
+		t.Errorf("calls = %d during burst, expected 0", calls)
+	}
+	if !d.Pending() {
+		t.Error("Pending() = false, expected a scheduled call")
+	}
+
+	clock.Advance(400 * Millisecond)
+	if calls != 1 {
+		t.Errorf("calls = %d after quiet period, expected 1", calls)
+	}
+	clock.Advance(Hour)
+	if calls != 1 {
+		t.Errorf("calls = %d, expected no repeat", calls)
+	}
+
+	d.Trigger()
+	if !d.Flush() || calls != 2 {
+		t.Errorf("Flush() should run the pending call, calls = %d", calls)
+	}
+	d.Trigger()
+	if !d.Stop() || d.Flush() {
+		t.Error("Stop() should cancel the pending call")
+	}
+	clock.Advance(Hour)
+	if calls != 2 {
+		t.Errorf("calls = %d after Stop, expected 2", calls)
+	}
+}
+
+func TestDebouncerSystemClock(t *testing.T) {
+	fired := make(chan struct{}, 2)
+	d, _ := NewDebouncer(nil, 10*Millisecond, func() { fired <- struct{}{} })
+	d.Trigger()
+	d.Trigger()
+	select {
+	case <-fired:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for debounced call")
+	}
+	select {
+	case <-fired:
+		t.Error("debounced function ran twice")
+	case <-time.After(30 * time.Millisecond):
+	}
+}
+
+func TestThrottler(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	clock := NewManualClock(start)
+	th, err := NewThrottler(clock, Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var calls atomic.Int32
+	f := func() { calls.Add(1) }
+	if !th.Do(f) {
+		t.Error("first Do() should run")
+	}
+	clock.Advance(999 * Millisecond)
+	if th.Do(f) {
+		t.Error("Do() inside window should not run")
+	}
+	if got := th.Next(); got != start+Timestamp(Second) {
+		t.Errorf("Next() = %s, expected end of window", got.Format())
+	}
+	clock.Advance(Millisecond)
+	if !th.Allow() {
+		t.Error("Allow() at end of window should admit")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, expected 1", calls.Load())
+	}
+}
+
+func TestDebounceThrottleInvalid(t *testing.T) {
+	if _, err := NewDebouncer(nil, 0, func() {}); err == nil {
+		t.Error("NewDebouncer(0) should fail")
+	}
+	if _, err := NewThrottler(nil, -Second); err == nil {
+		t.Error("NewThrottler(-1s) should fail")
+	}
+}
@@ -0,0 +1,256 @@
+package universal_timestamp
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration represents the elapsed time between two timestamps as an int64
+// nanosecond count.
+type Duration int64
+
+// Common durations.
+const (
+	Nanosecond  Duration = 1
+	Microsecond          = 1000 * Nanosecond
+	Millisecond          = 1000 * Microsecond
+	Second               = 1000 * Millisecond
+	Minute               = 60 * Second
+	Hour                 = 60 * Minute
+	Day                  = 24 * Hour
+)
+
+// FromStd converts a time.Duration to a Duration.
+func FromStd(d time.Duration) Duration {
+	return Duration(d)
+}
+
+// Std converts the duration to a time.Duration.
+func (d Duration) Std() time.Duration {
+	return time.Duration(d)
+}
+
+// String formats the duration in ISO-8601 notation using hours, minutes
+// and seconds, such as "PT1H32M10.5S". A zero duration is "PT0S".
+func (d Duration) String() string {
+	var b strings.Builder
+	remaining := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		remaining = uint64(-d)
+	}
+	b.WriteString("PT")
+
+	hours := remaining / uint64(Hour)
+	remaining -= hours * uint64(Hour)
+	minutes := remaining / uint64(Minute)
+	remaining -= minutes * uint64(Minute)
+	seconds := remaining / uint64(Second)
+	nanos := remaining - seconds*uint64(Second)
+
+	if hours > 0 {
+		b.WriteString(strconv.FormatUint(hours, 10))
+		b.WriteByte('H')
+	}
+	if minutes > 0 {
+		b.WriteString(strconv.FormatUint(minutes, 10))
+		b.WriteByte('M')
+	}
+	if seconds > 0 || nanos > 0 || (hours == 0 && minutes == 0) {
+		b.WriteString(strconv.FormatUint(seconds, 10))
+		if nanos > 0 {
+			frac := strconv.FormatUint(nanos+uint64(Second), 10)[1:]
+			b.WriteByte('.')
+			b.WriteString(strings.TrimRight(frac, "0"))
+		}
+		b.WriteByte('S')
+	}
+	return b.String()
+}
+
+// ParseDuration parses an ISO-8601 time-based duration such as
+// "PT1H32M10.5S", as produced by String. A leading '-' negates the
+// duration. Date components (years, months, days) are rejected because
+// their length varies; use ParsePeriod for those.
+func ParseDuration(s string) (Duration, error) {
+	neg := false
+	if strings.HasPrefix(s, "-") {
+		neg = true
+		s = s[1:]
+	}
+	if len(s) < 4 || (s[:2] != "PT" && s[:2] != "pt") {
+		return 0, ErrInvalidFormat
+	}
+
+	var d Duration
+	rest := s[2:]
+	last := -1
+	for rest != "" {
+		i := 0
+		for i < len(rest) && isDigit(rest[i]) {
+			i++
+		}
+		whole := rest[:i]
+		frac := ""
+		if i < len(rest) && (rest[i] == '.' || rest[i] == ',') {
+			j := i + 1
+			for j < len(rest) && isDigit(rest[j]) {
+				j++
+			}
+			frac = rest[i+1 : j]
+			i = j
+		}
+		if whole == "" || i == len(rest) {
+			return 0, ErrInvalidFormat
+		}
+
+		order := strings.IndexByte("HMS", rest[i]&^0x20)
+		if order <= last || (frac != "" && order != 2) {
+			return 0, ErrInvalidFormat
+		}
+		last = order
+
+		n, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil {
+			return 0, ErrOutOfRange
+		}
+		unit := []Duration{Hour, Minute, Second}[order]
+		if n > int64(1<<63-1)/int64(unit) {
+			return 0, ErrOutOfRange
+		}
+		part := Duration(n) * unit
+		if frac != "" {
+			if len(frac) > 9 {
+				return 0, ErrFractionTooLong
+			}
+			ns, _ := strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 64)
+			if part > Duration(1<<63-1)-Duration(ns) {
+				return 0, ErrOutOfRange
+			}
+			part += Duration(ns)
+		}
+		if d > Duration(1<<63-1)-part {
+			return 0, ErrOutOfRange
+		}
+		d += part
+		rest = rest[i+1:]
+	}
+
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
+
+// humanizeUnit describes one component of a humanized duration.
+type humanizeUnit struct {
+	size  Duration
+	short string
+	long  string
+}
+
+var humanizeUnits = []humanizeUnit{
+	{Day, "d", "day"},
+	{Hour, "h", "hour"},
+	{Minute, "m", "minute"},
+	{Second, "s", "second"},
+	{Millisecond, "ms", "millisecond"},
+	{Microsecond, "µs", "microsecond"},
+	{Nanosecond, "ns", "nanosecond"},
+}
+
+// humanizeConfig holds the settings applied by HumanizeOption values.
+type humanizeConfig struct {
+	largest    Duration
+	components int
+	long       bool
+}
+
+// HumanizeOption configures Duration.Humanize.
+type HumanizeOption func(*humanizeConfig)
+
+// HumanizeLargestUnit sets the largest unit used by Humanize; larger
+// quantities are expressed in this unit. The default is Day.
+func HumanizeLargestUnit(unit Duration) HumanizeOption {
+	return func(c *humanizeConfig) {
+		c.largest = unit
+	}
+}
+
+// HumanizeComponents limits Humanize to at most n non-zero components.
+// Remaining smaller components are truncated. Zero means no limit.
+func HumanizeComponents(n int) HumanizeOption {
+	return func(c *humanizeConfig) {
+		c.components = n
+	}
+}
+
+// HumanizeLong makes Humanize spell out unit names ("2 days 4 hours")
+// instead of using abbreviations ("2d 4h").
+func HumanizeLong() HumanizeOption {
+	return func(c *humanizeConfig) {
+		c.long = true
+	}
+}
+
+// Humanize formats the duration as a sequence of non-zero components,
+// such as "1h 32m 10s" or, with HumanizeLong, "2 days 4 hours".
+// A zero duration is rendered as "0s" (or "0 seconds").
+func (d Duration) Humanize(opts ...HumanizeOption) string {
+	cfg := humanizeConfig{largest: Day}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var b strings.Builder
+	remaining := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		remaining = uint64(-d)
+	}
+
+	written := 0
+	for _, u := range humanizeUnits {
+		if u.size > cfg.largest && u.size != Nanosecond {
+			continue
+		}
+		if cfg.components > 0 && written >= cfg.components {
+			break
+		}
+
+		n := remaining / uint64(u.size)
+		remaining -= n * uint64(u.size)
+		if n == 0 {
+			continue
+		}
+
+		if written > 0 {
+			b.WriteByte(' ')
+		}
+		writeHumanizeComponent(&b, n, u, cfg.long)
+		written++
+	}
+
+	if written == 0 {
+		if cfg.long {
+			return "0 seconds"
+		}
+		return "0s"
+	}
+	return b.String()
+}
+
+// writeHumanizeComponent appends a single "n unit" component to b.
+func writeHumanizeComponent(b *strings.Builder, n uint64, u humanizeUnit, long bool) {
+	b.WriteString(strconv.FormatUint(n, 10))
+	if !long {
+		b.WriteString(u.short)
+		return
+	}
+	b.WriteByte(' ')
+	b.WriteString(u.long)
+	if n != 1 {
+		b.WriteByte('s')
+	}
+}
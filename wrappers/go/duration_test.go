@@ -0,0 +1,96 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestHumanize(t *testing.T) {
+	cases := []struct {
+		d        Duration
+		opts     []HumanizeOption
+		expected string
+	}{
+		{0, nil, "0s"},
+		{Hour + 32*Minute + 10*Second, nil, "1h 32m 10s"},
+		{2*Day + 4*Hour, []HumanizeOption{HumanizeLong()}, "2 days 4 hours"},
+		{Day + Minute, []HumanizeOption{HumanizeLong()}, "1 day 1 minute"},
+		{2*Day + 4*Hour, []HumanizeOption{HumanizeLargestUnit(Hour)}, "52h"},
+		{Hour + 32*Minute + 10*Second, []HumanizeOption{HumanizeComponents(2)}, "1h 32m"},
+		{1500 * Millisecond, nil, "1s 500ms"},
+		{-90 * Second, nil, "-1m 30s"},
+		{0, []HumanizeOption{HumanizeLong()}, "0 seconds"},
+	}
+
+	for _, c := range cases {
+		if got := c.d.Humanize(c.opts...); got != c.expected {
+			t.Errorf("Humanize(%d) = %q, expected %q", int64(c.d), got, c.expected)
+		}
+	}
+}
+
+func TestDurationString(t *testing.T) {
+	cases := map[Duration]string{
+		0:                            "PT0S",
+		Hour + 32*Minute + 10*Second: "PT1H32M10S",
+		52 * Hour:                    "PT52H",
+		1500 * Millisecond:           "PT1.5S",
+		-90 * Second:                 "-PT1M30S",
+	}
+	for d, expected := range cases {
+		if got := d.String(); got != expected {
+			t.Errorf("String(%d) = %s, expected %s", int64(d), got, expected)
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	cases := map[string]Duration{
+		"PT1H32M10S": Hour + 32*Minute + 10*Second,
+		"PT52H":      52 * Hour,
+		"PT1.5S":     1500 * Millisecond,
+		"PT0,25S":    250 * Millisecond,
+		"-PT1M30S":   -90 * Second,
+		"PT0S":       0,
+	}
+	for input, expected := range cases {
+		got, err := ParseDuration(input)
+		if err != nil || got != expected {
+			t.Errorf("ParseDuration(%q) = %d, %v; expected %d", input, got, err, expected)
+		}
+		if err == nil {
+			back, err := ParseDuration(got.String())
+			if err != nil || back != got {
+				t.Errorf("ParseDuration(%q) did not round-trip", got.String())
+			}
+		}
+	}
+
+	for _, input := range []string{"", "PT", "P1D", "PT1.5H", "PT1S1M", "PT1", "1H"} {
+		if _, err := ParseDuration(input); err == nil {
+			t.Errorf("ParseDuration(%q) succeeded", input)
+		}
+	}
+}
+
+func TestParseDurationOverflow(t *testing.T) {
+	if d, err := ParseDuration("PT2562047H47M16.854775807S"); err != nil || d != math.MaxInt64 {
+		t.Errorf("ParseDuration(max) = %d, %v; expected %d", d, err, int64(math.MaxInt64))
+	}
+	for _, input := range []string{"PT2562047H48M", "PT2562047H47M17S", "PT2562047H47M16.854775808S", "PT9223372036.9S"} {
+		if _, err := ParseDuration(input); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("ParseDuration(%q) error = %v, expected ErrOutOfRange", input, err)
+		}
+	}
+}
+
+func TestDurationStd(t *testing.T) {
+	if got := (90 * Second).Std(); got != 90*time.Second {
+		t.Errorf("Std() = %v, expected 1m30s", got)
+	}
+	if got := FromStd(-1500 * time.Millisecond); got != -1500*Millisecond {
+		t.Errorf("FromStd() = %s, expected -PT1.5S", got)
+	}
+}
@@ -0,0 +1,150 @@
+package universal_timestamp
+
+import (
+	"math"
+	"strconv"
+)
+
+// Bounds of the whole seconds representable by a Timestamp.
+const (
+	maxEpochSeconds = math.MaxInt64 / int64(Second)
+	minEpochSeconds = math.MinInt64/int64(Second) - 1
+)
+
+// ToFloatSeconds returns the timestamp as seconds since the Unix epoch,
+// rounded to the nearest float64. A float64 carries about 16 significant
+// digits, so present-day values are only accurate to roughly 0.25µs; use
+// Timestamp itself where nanoseconds matter.
+func (t Timestamp) ToFloatSeconds() float64 {
+	sec := floorDiv(int64(t), int64(Second))
+	nsec := int64(t) - sec*int64(Second)
+	return float64(sec) + float64(nsec)/float64(Second)
+}
+
+// FromFloatSeconds converts seconds since the Unix epoch to a Timestamp,
+// rounding to the nearest nanosecond with ties away from zero. Most
+// present-day instants have no exact float64 form, so the result may be up
+// to about 0.25µs from the instant the sender intended. It returns
+// ErrOutOfRange for NaN, infinities and values outside the Timestamp range.
+func FromFloatSeconds(f float64) (Timestamp, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, ErrOutOfRange
+	}
+
+	sec := math.Floor(f)
+	if sec < float64(minEpochSeconds) || sec > float64(maxEpochSeconds) {
+		return 0, ErrOutOfRange
+	}
+	nsec := int64(math.Round((f - sec) * float64(Second)))
+	s := int64(sec)
+	if nsec >= int64(Second) {
+		s++
+		nsec -= int64(Second)
+	}
+	return fromEpochParts(s, nsec)
+}
+
+// fromEpochParts combines whole seconds and a non-negative nanosecond
+// remainder, reporting ErrOutOfRange on overflow.
+func fromEpochParts(sec, nsec int64) (Timestamp, error) {
+	if sec > maxEpochSeconds || sec < minEpochSeconds ||
+		(sec == maxEpochSeconds && nsec > math.MaxInt64%int64(Second)) ||
+		(sec == minEpochSeconds && nsec < int64(Second)+math.MinInt64%int64(Second)) {
+		return 0, ErrOutOfRange
+	}
+	return Timestamp(sec*int64(Second) + nsec), nil
+}
+
+// EpochDecimal formats the timestamp as decimal seconds since the Unix
+// epoch, such as "1702555200.123456789", without going through float64.
+// Trailing fractional zeros are omitted, as is the decimal point for whole
+// seconds. Instants before the epoch carry a leading '-'.
+func (t Timestamp) EpochDecimal() string {
+	var buf [24]byte
+	return string(t.AppendEpochDecimal(buf[:0]))
+}
+
+// AppendEpochDecimal appends the EpochDecimal form of the timestamp to dst.
+func (t Timestamp) AppendEpochDecimal(dst []byte) []byte {
+	mag := uint64(t)
+	if t < 0 {
+		dst = append(dst, '-')
+		mag = -mag
+	}
+	dst = strconv.AppendUint(dst, mag/uint64(Second), 10)
+
+	frac := mag % uint64(Second)
+	if frac == 0 {
+		return dst
+	}
+	var digits [9]byte
+	for i := 8; i >= 0; i-- {
+		digits[i] = byte('0' + frac%10)
+		frac /= 10
+	}
+	n := 9
+	for digits[n-1] == '0' {
+		n--
+	}
+	dst = append(dst, '.')
+	return append(dst, digits[:n]...)
+}
+
+// ParseEpochDecimal parses decimal seconds since the Unix epoch, such as
+// "1702555200.123456789" or "-0.5", exactly. At most nine fractional digits
+// are accepted.
+func ParseEpochDecimal(s string) (Timestamp, error) {
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	whole, frac := s, ""
+	for i := 0; i < len(s); i++ {
+		if s[i] == '.' {
+			whole, frac = s[:i], s[i+1:]
+			if frac == "" {
+				return 0, ErrInvalidFormat
+			}
+			break
+		}
+	}
+	if whole == "" {
+		return 0, ErrInvalidFormat
+	}
+	for i := 0; i < len(whole); i++ {
+		if !isDigit(whole[i]) {
+			return 0, ErrInvalidFormat
+		}
+	}
+
+	var nsec uint64
+	for i := 0; i < len(frac); i++ {
+		if !isDigit(frac[i]) {
+			return 0, ErrInvalidFormat
+		}
+	}
+	if len(frac) > 9 {
+		return 0, ErrFractionTooLong
+	}
+	for i := 0; i < 9; i++ {
+		nsec *= 10
+		if i < len(frac) {
+			nsec += uint64(frac[i] - '0')
+		}
+	}
+
+	sec, err := strconv.ParseUint(whole, 10, 64)
+	if err != nil || sec > uint64(maxEpochSeconds)+1 {
+		return 0, ErrOutOfRange
+	}
+	mag := sec*uint64(Second) + nsec
+	if mag > math.MaxInt64 && !(neg && mag == 1<<63) {
+		return 0, ErrOutOfRange
+	}
+	if neg {
+		return Timestamp(-mag), nil
+	}
+	return Timestamp(mag), nil
+}
@@ -0,0 +1,89 @@
+package universal_timestamp
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloatSeconds(t *testing.T) {
+	cases := map[float64]string{
+		1702555200:       "2023-12-14T12:00:00Z",
+		1702555200.5:     "2023-12-14T12:00:00.5Z",
+		-0.25:            "1969-12-31T23:59:59.75Z",
+		0:                "1970-01-01T00:00:00Z",
+		1.000000001:      "1970-01-01T00:00:01.000000001Z",
+		1702555200.12345: "2023-12-14T12:00:00.12345Z",
+	}
+	for f, expected := range cases {
+		ts, err := FromFloatSeconds(f)
+		if err != nil {
+			t.Errorf("FromFloatSeconds(%v) failed: %v", f, err)
+			continue
+		}
+		// Present-day values are only accurate to a fraction of a microsecond.
+		want := mustParse(t, expected)
+		if diff := ts - want; diff > 250 || diff < -250 {
+			t.Errorf("FromFloatSeconds(%v) = %s, expected %s", f, ts.Format(), expected)
+		}
+		if got := want.ToFloatSeconds(); got != f {
+			t.Errorf("ToFloatSeconds(%s) = %v, expected %v", expected, got, f)
+		}
+	}
+}
+
+func TestFromFloatSecondsRange(t *testing.T) {
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1e10, -1e10} {
+		if _, err := FromFloatSeconds(f); err != ErrOutOfRange {
+			t.Errorf("FromFloatSeconds(%v) = %v, expected ErrOutOfRange", f, err)
+		}
+	}
+}
+
+func TestEpochDecimal(t *testing.T) {
+	cases := map[string]string{
+		"2023-12-14T12:00:00.123456789Z": "1702555200.123456789",
+		"2023-12-14T12:00:00.5Z":         "1702555200.5",
+		"2023-12-14T12:00:00Z":           "1702555200",
+		"1969-12-31T23:59:59.75Z":        "-0.25",
+		"1970-01-01T00:00:00Z":           "0",
+	}
+	for input, expected := range cases {
+		ts := mustParse(t, input)
+		if got := ts.EpochDecimal(); got != expected {
+			t.Errorf("EpochDecimal(%s) = %s, expected %s", input, got, expected)
+		}
+		back, err := ParseEpochDecimal(expected)
+		if err != nil || back != ts {
+			t.Errorf("ParseEpochDecimal(%q) = %s, %v; expected %s", expected, back.Format(), err, input)
+		}
+	}
+
+	extremes := []Timestamp{math.MaxInt64, math.MinInt64}
+	for _, ts := range extremes {
+		back, err := ParseEpochDecimal(ts.EpochDecimal())
+		if err != nil || back != ts {
+			t.Errorf("ParseEpochDecimal(%q) = %d, %v", ts.EpochDecimal(), int64(back), err)
+		}
+	}
+}
+
+func TestParseEpochDecimalErrors(t *testing.T) {
+	cases := map[string]error{
+		"":                      ErrInvalidFormat,
+		".5":                    ErrInvalidFormat,
+		"1.":                    ErrInvalidFormat,
+		"1e9":                   ErrInvalidFormat,
+		"1.1234567890":          ErrFractionTooLong,
+		"9223372036.854775808":  ErrOutOfRange,
+		"-9223372036.854775809": ErrOutOfRange,
+		"99999999999999999999":  ErrOutOfRange,
+	}
+	for input, expected := range cases {
+		if _, err := ParseEpochDecimal(input); err != expected {
+			t.Errorf("ParseEpochDecimal(%q) = %v, expected %v", input, err, expected)
+		}
+	}
+	if ts, err := ParseEpochDecimal("+12.000"); err != nil || ts != 12*Timestamp(Second) {
+		t.Errorf("ParseEpochDecimal(\"+12.000\") = %d, %v", int64(ts), err)
+	}
+}
@@ -0,0 +1,215 @@
+package universal_timestamp
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// EpochSeconds is a count of whole seconds since the Unix epoch. Its JSON
+// and SQL forms are the bare integer, so a struct field's type documents
+// the wire precision.
+type EpochSeconds int64
+
+// EpochMillis is a count of milliseconds since the Unix epoch, marshaled as
+// the bare integer.
+type EpochMillis int64
+
+// EpochMicros is a count of microseconds since the Unix epoch, marshaled as
+// the bare integer.
+type EpochMicros int64
+
+// EpochSeconds returns t in whole seconds, reducing any fraction with the
+// Rounding mode configured with SetDefaults, which floors by default.
+func (t Timestamp) EpochSeconds() EpochSeconds {
+	return EpochSeconds(roundDiv(int64(t), int64(Second), Defaults().Rounding))
+}
+
+// EpochMillis returns t in milliseconds, reducing any fraction with the
+// Rounding mode configured with SetDefaults, which floors by default.
+func (t Timestamp) EpochMillis() EpochMillis {
+	return EpochMillis(roundDiv(int64(t), int64(Millisecond), Defaults().Rounding))
+}
+
+// EpochMicros returns t in microseconds, reducing any fraction with the
+// Rounding mode configured with SetDefaults, which floors by default.
+func (t Timestamp) EpochMicros() EpochMicros {
+	return EpochMicros(roundDiv(int64(t), int64(Microsecond), Defaults().Rounding))
+}
+
+// Timestamp converts e to a Timestamp. Values outside the Timestamp range
+// saturate.
+func (e EpochSeconds) Timestamp() Timestamp {
+	return epochToTimestamp(int64(e), Second)
+}
+
+// Timestamp converts e to a Timestamp. Values outside the Timestamp range
+// saturate.
+func (e EpochMillis) Timestamp() Timestamp {
+	return epochToTimestamp(int64(e), Millisecond)
+}
+
+// Timestamp converts e to a Timestamp. Values outside the Timestamp range
+// saturate.
+func (e EpochMicros) Timestamp() Timestamp {
+	return epochToTimestamp(int64(e), Microsecond)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e EpochSeconds) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(e), 10), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e EpochMillis) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(e), 10), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e EpochMicros) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(e), 10), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts an integer or a
+// quoted integer and returns ErrOutOfRange for values that do not fit in a
+// Timestamp. As usual for JSON, null leaves the value unchanged.
+func (e *EpochSeconds) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	v, err := unmarshalEpoch(data, Second)
+	if err == nil {
+		*e = EpochSeconds(v)
+	}
+	return err
+}
+
+// UnmarshalJSON implements json.Unmarshaler, as for EpochSeconds.
+func (e *EpochMillis) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	v, err := unmarshalEpoch(data, Millisecond)
+	if err == nil {
+		*e = EpochMillis(v)
+	}
+	return err
+}
+
+// UnmarshalJSON implements json.Unmarshaler, as for EpochSeconds.
+func (e *EpochMicros) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	v, err := unmarshalEpoch(data, Microsecond)
+	if err == nil {
+		*e = EpochMicros(v)
+	}
+	return err
+}
+
+// Value implements driver.Valuer, storing the integer count.
+func (e EpochSeconds) Value() (driver.Value, error) {
+	return int64(e), nil
+}
+
+// Value implements driver.Valuer, storing the integer count.
+func (e EpochMillis) Value() (driver.Value, error) {
+	return int64(e), nil
+}
+
+// Value implements driver.Valuer, storing the integer count.
+func (e EpochMicros) Value() (driver.Value, error) {
+	return int64(e), nil
+}
+
+// Scan implements sql.Scanner for integer columns, integer strings and
+// time.Time values, which are floored to whole seconds.
+func (e *EpochSeconds) Scan(src interface{}) error {
+	v, err := scanEpoch(src, Second)
+	if err == nil {
+		*e = EpochSeconds(v)
+	}
+	return err
+}
+
+// Scan implements sql.Scanner, as for EpochSeconds.
+func (e *EpochMillis) Scan(src interface{}) error {
+	v, err := scanEpoch(src, Millisecond)
+	if err == nil {
+		*e = EpochMillis(v)
+	}
+	return err
+}
+
+// Scan implements sql.Scanner, as for EpochSeconds.
+func (e *EpochMicros) Scan(src interface{}) error {
+	v, err := scanEpoch(src, Microsecond)
+	if err == nil {
+		*e = EpochMicros(v)
+	}
+	return err
+}
+
+// epochToTimestamp multiplies v by unit, saturating on overflow.
+func epochToTimestamp(v int64, unit Duration) Timestamp {
+	switch {
+	case v > math.MaxInt64/int64(unit):
+		return math.MaxInt64
+	case v < math.MinInt64/int64(unit):
+		return math.MinInt64
+	}
+	return Timestamp(v * int64(unit))
+}
+
+// checkEpoch reports ErrOutOfRange if v units do not fit in a Timestamp.
+func checkEpoch(v int64, unit Duration) error {
+	if v > math.MaxInt64/int64(unit) || v < math.MinInt64/int64(unit) {
+		return ErrOutOfRange
+	}
+	return nil
+}
+
+// unmarshalEpoch decodes a JSON integer, optionally quoted, counting units.
+func unmarshalEpoch(data []byte, unit Duration) (int64, error) {
+	if n := len(data); n >= 2 && data[0] == '"' && data[n-1] == '"' {
+		data = data[1 : n-1]
+	}
+	v, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return 0, ErrInvalidFormat
+	}
+	return v, checkEpoch(v, unit)
+}
+
+// scanEpoch converts a non-NULL database value to a count of units.
+func scanEpoch(src interface{}, unit Duration) (int64, error) {
+	var v int64
+	switch s := src.(type) {
+	case int64:
+		v = s
+	case string:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, ErrInvalidFormat
+		}
+		v = n
+	case []byte:
+		n, err := strconv.ParseInt(string(s), 10, 64)
+		if err != nil {
+			return 0, ErrInvalidFormat
+		}
+		v = n
+	case time.Time:
+		ts, err := scanTimestamp(s)
+		if err != nil {
+			return 0, err
+		}
+		return floorDiv(int64(ts), int64(unit)), nil
+	default:
+		return 0, fmt.Errorf("cannot scan %T into an epoch count", src)
+	}
+	return v, checkEpoch(v, unit)
+}
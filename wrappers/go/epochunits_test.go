@@ -0,0 +1,95 @@
+package universal_timestamp
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEpochUnitConversions(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00.123456789Z")
+	if got := ts.EpochSeconds(); got != 1734177600 {
+		t.Errorf("EpochSeconds() = %d, expected 1734177600", got)
+	}
+	if got := ts.EpochMillis(); got != 1734177600123 {
+		t.Errorf("EpochMillis() = %d, expected 1734177600123", got)
+	}
+	if got := ts.EpochMicros(); got != 1734177600123456 {
+		t.Errorf("EpochMicros() = %d, expected 1734177600123456", got)
+	}
+	if got := ts.EpochMillis().Timestamp(); got != mustParse(t, "2024-12-14T12:00:00.123Z") {
+		t.Errorf("EpochMillis().Timestamp() = %s", got.Format())
+	}
+
+	pre := mustParse(t, "1969-12-31T23:59:59.5Z")
+	if got := pre.EpochSeconds(); got != -1 {
+		t.Errorf("EpochSeconds(pre-epoch) = %d, expected -1", got)
+	}
+	if got := EpochSeconds(math.MaxInt64).Timestamp(); got != math.MaxInt64 {
+		t.Errorf("EpochSeconds(MaxInt64).Timestamp() = %d, expected saturation", got)
+	}
+}
+
+func TestEpochUnitJSON(t *testing.T) {
+	type event struct {
+		Sec   EpochSeconds `json:"sec"`
+		Milli EpochMillis  `json:"ms"`
+		Micro EpochMicros  `json:"us"`
+	}
+	ts := mustParse(t, "2024-12-14T12:00:00.123456Z")
+	in := event{ts.EpochSeconds(), ts.EpochMillis(), ts.EpochMicros()}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"sec":1734177600,"ms":1734177600123,"us":1734177600123456}`
+	if string(data) != expected {
+		t.Errorf("Marshal() = %s, expected %s", data, expected)
+	}
+
+	var out event
+	if err := json.Unmarshal(data, &out); err != nil || out != in {
+		t.Errorf("Unmarshal() = %+v, %v, expected %+v", out, err, in)
+	}
+	if err := json.Unmarshal([]byte(`{"ms":"1734177600123"}`), &out); err != nil || out.Milli != in.Milli {
+		t.Errorf("Unmarshal(quoted) = %d, %v", out.Milli, err)
+	}
+	if err := json.Unmarshal([]byte(`{"sec":null,"ms":null,"us":null}`), &out); err != nil || out != in {
+		t.Errorf("Unmarshal(null) = %+v, %v, expected %+v", out, err, in)
+	}
+	if err := json.Unmarshal([]byte(`{"sec":1.5}`), &out); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Unmarshal(1.5) error = %v, expected ErrInvalidFormat", err)
+	}
+	if err := json.Unmarshal([]byte(`{"sec":9300000000000000000}`), &out); err == nil {
+		t.Error("Unmarshal(overflow) should fail")
+	}
+	if err := json.Unmarshal([]byte(`{"sec":9223372037}`), &out); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Unmarshal(9223372037) error = %v, expected ErrOutOfRange", err)
+	}
+}
+
+func TestEpochUnitSQL(t *testing.T) {
+	var ms EpochMillis
+	for _, src := range []interface{}{int64(1734177600123), "1734177600123", []byte("1734177600123")} {
+		if err := ms.Scan(src); err != nil || ms != 1734177600123 {
+			t.Errorf("Scan(%v) = %d, %v", src, ms, err)
+		}
+	}
+	if err := ms.Scan(time.Unix(1734177600, 123456789)); err != nil || ms != 1734177600123 {
+		t.Errorf("Scan(time) = %d, %v", ms, err)
+	}
+	if err := ms.Scan(nil); err == nil {
+		t.Error("Scan(nil) should fail")
+	}
+	if err := ms.Scan(int64(math.MaxInt64)); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Scan(MaxInt64) error = %v, expected ErrOutOfRange", err)
+	}
+
+	v, err := EpochMicros(42).Value()
+	if err != nil || v != int64(42) {
+		t.Errorf("Value() = %v, %v, expected int64 42", v, err)
+	}
+}
@@ -0,0 +1,43 @@
+package universal_timestamp
+
+import "errors"
+
+// ErrOutOfRange is returned when a value cannot be represented in the
+// target type or format.
+var ErrOutOfRange = errors.New("timestamp out of range")
+
+// ErrInvalidPrecision is returned when a fractional-second precision is
+// outside the range supported by the target format.
+var ErrInvalidPrecision = errors.New("invalid precision")
+
+// ErrFractionTooLong is returned when an input has more fractional-second
+// digits than allowed.
+var ErrFractionTooLong = errors.New("fractional seconds too long")
+
+// ErrInvalidFormat is returned when an input does not match the expected
+// pattern.
+var ErrInvalidFormat = errors.New("invalid timestamp format")
+
+// ErrAmbiguousDate is returned when a slash- or dot-separated date can be
+// read more than one way and no DateOrder was given.
+var ErrAmbiguousDate = errors.New("ambiguous date order")
+
+// ErrUnknownZone is returned when a time zone name or abbreviation is not
+// recognized.
+var ErrUnknownZone = errors.New("unknown time zone")
+
+// ErrUnsupportedClock is returned when a clock source is not available on
+// the current platform.
+var ErrUnsupportedClock = errors.New("clock source not supported")
+
+// ErrBufferTooSmall is returned by the *Checked format functions when the
+// C core cannot render a value into its buffer.
+var ErrBufferTooSmall = errors.New("format buffer too small")
+
+// ErrAlreadyInitialized is returned by Init when it is called again without
+// Close.
+var ErrAlreadyInitialized = errors.New("already initialized")
+
+// ErrClockDivergence is returned when the C core's clock and the Go
+// runtime's disagree by more than the allowed tolerance.
+var ErrClockDivergence = errors.New("clock divergence")
@@ -0,0 +1,74 @@
+package universal_timestamp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// exifLayout is the form of the EXIF DateTime, DateTimeOriginal and
+// DateTimeDigitized tags.
+const exifLayout = "2006:01:02 15:04:05"
+
+// ParseEXIF combines an EXIF date/time tag such as DateTimeOriginal
+// ("2024:12:14 12:00:00") with its SubSecTime and OffsetTime sidecar tags
+// ("123" and "+01:00") into a single instant. Either sidecar may be empty.
+// Cameras that predate the OffsetTime tags record wall-clock time only;
+// without an offset the time is read in loc, typically the zone the photo
+// was taken in, and the result is in loc. A nil loc is treated as UTC.
+//
+// Tag values may carry the trailing NUL and space padding EXIF allows. A
+// date left blank or zeroed by the camera, such as "    :  :     :  :  ",
+// returns ErrInvalidFormat, as does other malformed input; a blank offset
+// is treated as missing.
+func ParseEXIF(datetime, subsec, offset string, loc *time.Location) (ZonedTimestamp, error) {
+	datetime, subsec, offset = trimEXIF(datetime), trimEXIF(subsec), trimEXIF(offset)
+
+	t, err := time.Parse(exifLayout, datetime)
+	if err != nil {
+		return ZonedTimestamp{}, fmt.Errorf("%w: EXIF date/time %q", ErrInvalidFormat, datetime)
+	}
+
+	var nanos Duration
+	if subsec != "" {
+		scale := Duration(Second)
+		for i := 0; i < len(subsec); i++ {
+			if !isDigit(subsec[i]) {
+				return ZonedTimestamp{}, fmt.Errorf("%w: EXIF sub-second time %q", ErrInvalidFormat, subsec)
+			}
+			if scale > 1 {
+				scale /= 10
+				nanos += Duration(subsec[i]-'0') * scale
+			}
+		}
+	}
+
+	if strings.Trim(offset, " :") != "" {
+		off, ok := numericOffset([]byte(offset))
+		if !ok {
+			return ZonedTimestamp{}, fmt.Errorf("%w: EXIF offset %q", ErrInvalidFormat, offset)
+		}
+		loc = offsetZone(off)
+	} else if loc == nil {
+		loc = time.UTC
+	}
+	return ZonedDate(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), int(nanos), loc), nil
+}
+
+// FormatEXIF splits z into the values of an EXIF date/time tag and its
+// SubSecTime and OffsetTime sidecars, written in z's location: for
+// example "2024:12:14 13:00:00", "5" and "+01:00". subsec holds the
+// fraction without trailing zeros, and is empty for a whole second.
+func FormatEXIF(z ZonedTimestamp) (datetime, subsec, offset string) {
+	t := z.Time()
+	if ns := t.Nanosecond(); ns != 0 {
+		subsec = strings.TrimRight(fmt.Sprintf("%09d", ns), "0")
+	}
+	return t.Format(exifLayout), subsec, t.Format("-07:00")
+}
+
+// trimEXIF removes the NUL terminator and space padding of an EXIF ASCII
+// tag value.
+func trimEXIF(s string) string {
+	return strings.Trim(s, "\x00 ")
+}
@@ -0,0 +1,91 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseEXIF(t *testing.T) {
+	cases := []struct {
+		datetime, subsec, offset string
+		instant                  string
+		utcOffset                int
+	}{
+		{"2024:12:14 13:00:00", "123", "+01:00", "2024-12-14T12:00:00.123Z", 3600},
+		{"2024:12:14 12:00:00", "", "", "2024-12-14T12:00:00Z", 0},
+		{"2024:12:14 07:00:00\x00", "05 ", "-05:00\x00", "2024-12-14T12:00:00.05Z", -18000},
+		{"2024:12:14 12:00:00", "1234567891", "   :  ", "2024-12-14T12:00:00.123456789Z", 0},
+	}
+	for _, c := range cases {
+		z, err := ParseEXIF(c.datetime, c.subsec, c.offset, nil)
+		if err != nil {
+			t.Fatalf("ParseEXIF(%q, %q, %q) error: %v", c.datetime, c.subsec, c.offset, err)
+		}
+		if got := z.Instant.Format(); got != c.instant {
+			t.Errorf("ParseEXIF(%q, %q, %q) = %s, expected %s", c.datetime, c.subsec, c.offset, got, c.instant)
+		}
+		if got := z.Offset(); got != c.utcOffset {
+			t.Errorf("ParseEXIF(%q, %q, %q) offset = %d, expected %d", c.datetime, c.subsec, c.offset, got, c.utcOffset)
+		}
+	}
+
+	bad := [][3]string{
+		{"    :  :     :  :  ", "", ""},
+		{"0000:00:00 00:00:00", "", ""},
+		{"2024-12-14 12:00:00", "", ""},
+		{"2024:12:14 12:00:00", "12a", ""},
+		{"2024:12:14 12:00:00", "", "+0100"},
+	}
+	for _, b := range bad {
+		if _, err := ParseEXIF(b[0], b[1], b[2], nil); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseEXIF(%q, %q, %q) error = %v, expected ErrInvalidFormat", b[0], b[1], b[2], err)
+		}
+	}
+}
+
+func TestParseEXIFZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	z, err := ParseEXIF("2024:07:04 08:00:00", "", "", loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z.Instant.Format() != "2024-07-04T12:00:00Z" || z.Location != loc {
+		t.Errorf("ParseEXIF(New_York) = %s, expected 2024-07-04T08:00:00-04:00[America/New_York]", z)
+	}
+
+	// A recorded offset takes precedence over loc.
+	z, err = ParseEXIF("2024:07:04 08:00:00", "", "+02:00", loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z.Instant.Format() != "2024-07-04T06:00:00Z" {
+		t.Errorf("ParseEXIF(+02:00) = %s, expected 2024-07-04T06:00:00Z", z.Instant.Format())
+	}
+}
+
+func TestFormatEXIF(t *testing.T) {
+	z := NewZoned(mustParse(t, "2024-12-14T12:00:00.5Z"), time.FixedZone("", 3600))
+	datetime, subsec, offset := FormatEXIF(z)
+	if datetime != "2024:12:14 13:00:00" || subsec != "5" || offset != "+01:00" {
+		t.Errorf("FormatEXIF() = %q, %q, %q, expected %q, %q, %q",
+			datetime, subsec, offset, "2024:12:14 13:00:00", "5", "+01:00")
+	}
+
+	back, err := ParseEXIF(datetime, subsec, offset, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.Instant != z.Instant {
+		t.Errorf("ParseEXIF(FormatEXIF()) = %s, expected %s", back, z)
+	}
+
+	_, subsec, offset = FormatEXIF(NewZoned(mustParse(t, "2024-12-14T12:00:00Z"), nil))
+	if subsec != "" || offset != "+00:00" {
+		t.Errorf("FormatEXIF(UTC) sidecars = %q, %q, expected %q, %q", subsec, offset, "", "+00:00")
+	}
+}
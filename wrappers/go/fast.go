@@ -0,0 +1,52 @@
+package universal_timestamp
+
+import "time"
+
+// fastClockTolerance is how far the Go runtime clock may disagree with the
+// C core at startup before NowFast stops trusting it.
+const fastClockTolerance = Millisecond
+
+// fastClockConsistent records the startup comparison of the Go runtime
+// clock with ut_now.
+var fastClockConsistent = checkFastClock()
+
+// checkFastClock reports whether CheckClockConsistency finds the Go
+// runtime clock within fastClockTolerance of the C core.
+func checkFastClock() bool {
+	_, err := CheckClockConsistency(fastClockTolerance)
+	return err == nil
+}
+
+// NowFast returns the current UTC timestamp from the Go runtime clock,
+// avoiding the cgo call made by Now. Both read the system real-time clock,
+// which is checked at startup; if the two disagree, NowFast falls back to
+// Now.
+func NowFast() Timestamp {
+	if fastClockConsistent {
+		return Timestamp(time.Now().UnixNano())
+	}
+	return Now()
+}
+
+// FastClockConsistent reports whether the startup check found the Go
+// runtime clock consistent with the C core, that is whether NowFast avoids
+// cgo.
+func FastClockConsistent() bool {
+	return fastClockConsistent
+}
+
+// fastClock reads the current time with NowFast.
+type fastClock struct{}
+
+// Now returns NowFast().
+func (fastClock) Now() Timestamp {
+	return NowFast()
+}
+
+// Until returns the duration from NowFast() until ts.
+func (fastClock) Until(ts Timestamp) Duration {
+	return Duration(ts - NowFast())
+}
+
+// FastClock is the Clock backed by NowFast.
+var FastClock Clock = fastClock{}
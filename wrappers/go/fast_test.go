@@ -0,0 +1,19 @@
+package universal_timestamp
+
+import "testing"
+
+func TestNowFast(t *testing.T) {
+	if !FastClockConsistent() {
+		t.Fatal("Go runtime clock disagrees with the C core")
+	}
+
+	before := Now()
+	fast := NowFast()
+	after := Now()
+	if fast < before-Timestamp(fastClockTolerance) || fast > after+Timestamp(fastClockTolerance) {
+		t.Errorf("NowFast() = %s, outside [%s, %s]", fast.Format(), before.Format(), after.Format())
+	}
+	if FastClock.Until(fast) > 0 {
+		t.Error("FastClock.Until() of a past reading is positive")
+	}
+}
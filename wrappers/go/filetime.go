@@ -0,0 +1,24 @@
+package universal_timestamp
+
+import "os"
+
+// ModTime returns the modification time of fi with nanosecond precision.
+func ModTime(fi os.FileInfo) Timestamp {
+	return FromTime(fi.ModTime())
+}
+
+// FileModTime returns the modification time of the named file. Symbolic
+// links are followed.
+func FileModTime(path string) (Timestamp, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	return ModTime(fi), nil
+}
+
+// Chtimes changes the access and modification times of the named file, as
+// os.Chtimes does. The precision actually stored depends on the file system.
+func Chtimes(path string, atime, mtime Timestamp) error {
+	return os.Chtimes(path, atime.ToTime(), mtime.ToTime())
+}
@@ -0,0 +1,43 @@
+package universal_timestamp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestChtimesRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	mtime := mustParse(t, "2024-12-14T12:00:00.123456789Z")
+	atime := mustParse(t, "2024-12-14T11:00:00Z")
+	if err := Chtimes(path, atime, mtime); err != nil {
+		t.Fatalf("Chtimes() failed: %v", err)
+	}
+
+	got, err := FileModTime(path)
+	if err != nil {
+		t.Fatalf("FileModTime() failed: %v", err)
+	}
+	// Some file systems store coarser times; require at least seconds.
+	if got/Timestamp(Second) != mtime/Timestamp(Second) {
+		t.Errorf("FileModTime() = %s, expected %s", got.Format(), mtime.Format())
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ModTime(fi) != got {
+		t.Errorf("ModTime() = %s, expected %s", ModTime(fi).Format(), got.Format())
+	}
+}
+
+func TestFileModTimeMissing(t *testing.T) {
+	if _, err := FileModTime(filepath.Join(t.TempDir(), "missing")); !os.IsNotExist(err) {
+		t.Errorf("FileModTime() of a missing file = %v", err)
+	}
+}
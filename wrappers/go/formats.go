@@ -0,0 +1,87 @@
+package universal_timestamp
+
+// FormatDescriptor describes one input form accepted by the parser.
+type FormatDescriptor struct {
+	// Name is a short human-readable label.
+	Name string
+	// Pattern shows the layout, such as "YYYY-MM-DDTHH:MM:SSZ".
+	Pattern string
+	// Example is an input in this form.
+	Example string
+	// Strict reports whether Parse accepts the form with no options.
+	Strict bool
+	// Requires names what enables the form when it is not strict, such as
+	// "Config.Lenient" or "AllowSpaceSeparator()".
+	Requires string
+
+	lenient bool
+	opts    []ParseOption
+}
+
+// SupportedFormats describes the input forms the parser accepts and how to
+// enable each of them. The returned slice is a fresh copy.
+func SupportedFormats() []FormatDescriptor {
+	return []FormatDescriptor{
+		{
+			Name:    "RFC 3339 UTC",
+			Pattern: "YYYY-MM-DDTHH:MM:SSZ",
+			Example: "2024-12-14T03:13:21Z",
+			Strict:  true,
+		},
+		{
+			Name:    "RFC 3339 UTC with fraction",
+			Pattern: "YYYY-MM-DDTHH:MM:SS.nnnnnnnnnZ",
+			Example: "2024-12-14T03:13:21.123456789Z",
+			Strict:  true,
+		},
+		{
+			Name:     "Lowercase zone designator",
+			Pattern:  "YYYY-MM-DDTHH:MM:SSz",
+			Example:  "2024-12-14T03:13:21z",
+			Requires: "Config.Lenient",
+			lenient:  true,
+		},
+		{
+			Name:     "Zero numeric offset",
+			Pattern:  "YYYY-MM-DDTHH:MM:SS+00:00",
+			Example:  "2024-12-14T03:13:21+00:00",
+			Requires: "Config.Lenient",
+			lenient:  true,
+		},
+		{
+			Name:     "No zone designator",
+			Pattern:  "YYYY-MM-DDTHH:MM:SS",
+			Example:  "2024-12-14T03:13:21",
+			Requires: "AllowNoOffset() or AssumeZone(loc)",
+			opts:     []ParseOption{AllowNoOffset()},
+		},
+		{
+			Name:     "Space separator",
+			Pattern:  "YYYY-MM-DD HH:MM:SSZ",
+			Example:  "2024-12-14 03:13:21Z",
+			Requires: "AllowSpaceSeparator()",
+			opts:     []ParseOption{AllowSpaceSeparator()},
+		},
+		{
+			Name:     "Two-digit year",
+			Pattern:  "YY-MM-DDTHH:MM:SSZ",
+			Example:  "24-12-14T03:13:21Z",
+			Requires: "TwoDigitYears(pivot)",
+			opts:     []ParseOption{TwoDigitYears(69)},
+		},
+		{
+			Name:     "Slash or dot date",
+			Pattern:  "DD/MM/YYYY, MM/DD/YYYY or DD.MM.YYYY",
+			Example:  "14/12/2024T03:13:21Z",
+			Requires: "SlashDates(order)",
+			opts:     []ParseOption{SlashDates(DMY)},
+		},
+		{
+			Name:     "Zone abbreviation",
+			Pattern:  "YYYY-MM-DDTHH:MM:SS ZZZ",
+			Example:  "2024-12-13T22:13:21 EST",
+			Requires: "AllowZoneAbbreviations(overrides)",
+			opts:     []ParseOption{AllowZoneAbbreviations(nil)},
+		},
+	}
+}
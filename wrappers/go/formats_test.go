@@ -0,0 +1,29 @@
+package universal_timestamp
+
+import "testing"
+
+// TestSupportedFormatsParity checks that every described form parses as
+// described, so the descriptors cannot drift from the parser.
+func TestSupportedFormatsParity(t *testing.T) {
+	expected := mustParse(t, "2024-12-14T03:13:21Z")
+	for _, f := range SupportedFormats() {
+		if f.Strict != (f.Requires == "") {
+			t.Errorf("%s: Strict = %v but Requires = %q", f.Name, f.Strict, f.Requires)
+		}
+
+		_, strictErr := Parse(f.Example)
+		if f.Strict != (strictErr == nil) {
+			t.Errorf("%s: strict Parse(%q) error = %v, Strict = %v", f.Name, f.Example, strictErr, f.Strict)
+		}
+
+		codec := NewCodec(Config{Lenient: f.lenient})
+		ts, err := codec.Parse(f.Example, f.opts...)
+		if err != nil {
+			t.Errorf("%s: Parse(%q) with %s failed: %v", f.Name, f.Example, f.Requires, err)
+			continue
+		}
+		if ts/Timestamp(Second) != expected/Timestamp(Second) {
+			t.Errorf("%s: Parse(%q) = %s, expected %s", f.Name, f.Example, ts.Format(), expected.Format())
+		}
+	}
+}
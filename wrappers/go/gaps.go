@@ -0,0 +1,16 @@
+package universal_timestamp
+
+// FindGaps reports the stretches of a regularly sampled series where
+// samples are missing. ts must be sorted. A gap is reported wherever two
+// consecutive samples are more than expected+tolerance apart; it covers
+// the instants at which samples were due, from one expected interval after
+// the earlier sample up to the later sample.
+func FindGaps(ts Timestamps, expected, tolerance Duration) []Interval {
+	var gaps []Interval
+	for i := 1; i < len(ts); i++ {
+		if Duration(ts[i]-ts[i-1]) > expected+tolerance {
+			gaps = append(gaps, Interval{Start: ts[i-1] + Timestamp(expected), End: ts[i]})
+		}
+	}
+	return gaps
+}
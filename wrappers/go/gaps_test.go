@@ -0,0 +1,36 @@
+package universal_timestamp
+
+import "testing"
+
+func TestFindGaps(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	at := func(seconds ...int64) Timestamps {
+		ts := make(Timestamps, len(seconds))
+		for i, s := range seconds {
+			ts[i] = start + Timestamp(s)*Timestamp(Second)
+		}
+		return ts
+	}
+
+	series := at(0, 10, 20, 31, 60, 70, 100)
+	gaps := FindGaps(series, 10*Second, 2*Second)
+	expected := []string{
+		"2024-12-14T12:00:41Z/2024-12-14T12:01:00Z",
+		"2024-12-14T12:01:20Z/2024-12-14T12:01:40Z",
+	}
+	if len(gaps) != len(expected) {
+		t.Fatalf("FindGaps() = %v, expected %v", gaps, expected)
+	}
+	for i, g := range gaps {
+		if g.String() != expected[i] {
+			t.Errorf("gap %d = %s, expected %s", i, g, expected[i])
+		}
+	}
+
+	if gaps := FindGaps(at(0, 10, 20), 10*Second, 0); len(gaps) != 0 {
+		t.Errorf("FindGaps() of a regular series = %v", gaps)
+	}
+	if gaps := FindGaps(nil, Second, 0); len(gaps) != 0 {
+		t.Errorf("FindGaps(nil) = %v", gaps)
+	}
+}
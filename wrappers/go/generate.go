@@ -0,0 +1,78 @@
+package universal_timestamp
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// Bounds of the range that Generate draws from. They stay inside the span
+// representable by int64 nanoseconds with whole years to spare.
+const (
+	generateMin Timestamp = -9183024000000000000
+	generateMax Timestamp = 9214646399999999999
+)
+
+// generateEdges are instants around which bugs tend to cluster: the epoch,
+// leap days, century boundaries and common DST transitions.
+var generateEdges = []Timestamp{
+	0,
+	-1,
+	-2208988800000000000,
+	-2203891200000000000,
+	951782400000000000,
+	951868800000000000,
+	1709164800000000000,
+	1710054000000000000,
+	1711846800000000000,
+	1730613600000000000,
+	1729990800000000000,
+	4107542400000000000,
+}
+
+// Generator produces pseudo-random timestamps.
+type Generator func(r *rand.Rand) Timestamp
+
+// GenBetween returns a Generator producing timestamps uniformly distributed
+// in [a, b]. Arguments are swapped if b is before a.
+func GenBetween(a, b Timestamp) Generator {
+	if b < a {
+		a, b = b, a
+	}
+	return func(r *rand.Rand) Timestamp {
+		span := uint64(b - a)
+		if span == 1<<64-1 {
+			return Timestamp(r.Uint64())
+		}
+		return a + Timestamp(r.Uint64()%(span+1))
+	}
+}
+
+// GenEdges returns a Generator that picks instants within spread of the
+// built-in edge cases (epoch, leap days, century and DST boundaries).
+func GenEdges(spread Duration) Generator {
+	return func(r *rand.Rand) Timestamp {
+		edge := generateEdges[r.Intn(len(generateEdges))]
+		if spread <= 0 {
+			return edge
+		}
+		return edge + Timestamp(r.Int63n(2*int64(spread)+1)-int64(spread))
+	}
+}
+
+// Values fills args with generated timestamps. Its signature matches the
+// Values field of testing/quick.Config.
+func (g Generator) Values(args []reflect.Value, r *rand.Rand) {
+	for i := range args {
+		args[i] = reflect.ValueOf(g(r))
+	}
+}
+
+// Generate implements testing/quick.Generator. Roughly one value in four is
+// taken from near the built-in edge cases; the rest are spread uniformly
+// between the years 1679 and 2261.
+func (Timestamp) Generate(r *rand.Rand, size int) reflect.Value {
+	if r.Intn(4) == 0 {
+		return reflect.ValueOf(GenEdges(Second)(r))
+	}
+	return reflect.ValueOf(GenBetween(generateMin, generateMax)(r))
+}
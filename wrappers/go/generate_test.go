@@ -0,0 +1,52 @@
+package universal_timestamp
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+func TestGenerateRoundTrip(t *testing.T) {
+	roundTrip := func(ts Timestamp) bool {
+		parsed, err := Parse(ts.Format())
+		return err == nil && parsed == ts
+	}
+	if err := quick.Check(roundTrip, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGenBetween(t *testing.T) {
+	a := mustParse(t, "1960-01-01T00:00:00Z")
+	b := mustParse(t, "1980-01-01T00:00:00Z")
+	gen := GenBetween(b, a)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		if ts := gen(r); ts < a || ts > b {
+			t.Fatalf("GenBetween produced %s outside range", ts.Format())
+		}
+	}
+
+	inRange := func(ts Timestamp) bool { return ts >= a && ts <= b }
+	if err := quick.Check(inRange, &quick.Config{Values: gen.Values}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGenEdges(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	gen := GenEdges(0)
+	for i := 0; i < 100; i++ {
+		ts := gen(r)
+		found := false
+		for _, e := range generateEdges {
+			if e == ts {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("GenEdges(0) produced non-edge %s", ts.Format())
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package universal_timestamp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// gitDefaultLayout is git's default date format, as printed by git log.
+const gitDefaultLayout = "Mon Jan 2 15:04:05 2006 -0700"
+
+// ParseGitDate parses a date as printed by git: either the default format
+// of git log, "Sat Dec 14 12:00:00 2024 +0000", or the raw form of
+// --date=raw and commit objects, "1734177600 +0000". The result keeps the
+// offset the author or committer recorded as a fixed zone. It returns
+// ErrInvalidFormat for other input.
+func ParseGitDate(s string) (ZonedTimestamp, error) {
+	s = strings.TrimSpace(s)
+	if secs, off, ok := strings.Cut(s, " "); ok && !strings.Contains(off, " ") {
+		if n, err := strconv.ParseInt(secs, 10, 64); err == nil {
+			offset, ok := parseGitOffset(off)
+			if !ok {
+				return ZonedTimestamp{}, fmt.Errorf("%w: git offset %q", ErrInvalidFormat, off)
+			}
+			if err := checkEpoch(n, Second); err != nil {
+				return ZonedTimestamp{}, fmt.Errorf("%w: %q", err, s)
+			}
+			return NewZoned(Timestamp(n*int64(Second)), offsetZone(offset)), nil
+		}
+	}
+
+	t, err := time.Parse(gitDefaultLayout, s)
+	if err != nil {
+		return ZonedTimestamp{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	_, offset := t.Zone()
+	return NewZoned(FromTime(t), offsetZone(offset)), nil
+}
+
+// FormatGitDate formats z in git's default date format, such as
+// "Sat Dec 14 12:00:00 2024 +0000", in z's location.
+func FormatGitDate(z ZonedTimestamp) string {
+	return z.Time().Format(gitDefaultLayout)
+}
+
+// FormatGitRaw formats z in git's raw form, such as "1734177600 +0000":
+// whole seconds since the Unix epoch, floored, and z's UTC offset.
+func FormatGitRaw(z ZonedTimestamp) string {
+	secs := floorDiv(int64(z.Instant), int64(Second))
+	return strconv.FormatInt(secs, 10) + " " + z.Time().Format("-0700")
+}
+
+// parseGitOffset parses a "+hhmm" or "-hhmm" offset into seconds east of
+// UTC.
+func parseGitOffset(s string) (int, bool) {
+	if len(s) != 5 || s[0] != '+' && s[0] != '-' {
+		return 0, false
+	}
+	for i := 1; i < 5; i++ {
+		if !isDigit(s[i]) {
+			return 0, false
+		}
+	}
+	hours, minutes := atoiDigits([]byte(s[1:3])), atoiDigits([]byte(s[3:5]))
+	if minutes > 59 {
+		return 0, false
+	}
+	offset := hours*3600 + minutes*60
+	if s[0] == '-' {
+		offset = -offset
+	}
+	return offset, true
+}
@@ -0,0 +1,62 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseGitDate(t *testing.T) {
+	cases := []struct {
+		in      string
+		instant string
+		offset  int
+	}{
+		{"Sat Dec 14 12:00:00 2024 +0000", "2024-12-14T12:00:00Z", 0},
+		{"Wed Dec 4 17:30:00 2024 +0530", "2024-12-04T12:00:00Z", 19800},
+		{"1734177600 +0000", "2024-12-14T12:00:00Z", 0},
+		{"1734177600 -0800", "2024-12-14T12:00:00Z", -28800},
+		{"  1734177600 +0100\n", "2024-12-14T12:00:00Z", 3600},
+	}
+	for _, c := range cases {
+		z, err := ParseGitDate(c.in)
+		if err != nil {
+			t.Fatalf("ParseGitDate(%q) error: %v", c.in, err)
+		}
+		if got := z.Instant.Format(); got != c.instant {
+			t.Errorf("ParseGitDate(%q) = %s, expected %s", c.in, got, c.instant)
+		}
+		if got := z.Offset(); got != c.offset {
+			t.Errorf("ParseGitDate(%q) offset = %d, expected %d", c.in, got, c.offset)
+		}
+	}
+
+	for _, s := range []string{"", "1734177600", "1734177600 +01", "1734177600 +0160", "2024-12-14T12:00:00Z"} {
+		if _, err := ParseGitDate(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseGitDate(%q) error = %v, expected ErrInvalidFormat", s, err)
+		}
+	}
+	if _, err := ParseGitDate("99999999999 +0000"); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("ParseGitDate(out of range) error = %v, expected ErrOutOfRange", err)
+	}
+}
+
+func TestFormatGitDate(t *testing.T) {
+	z, err := ParseGitDate("1733328000 +0530")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := FormatGitDate(z); got != "Wed Dec 4 21:30:00 2024 +0530" {
+		t.Errorf("FormatGitDate() = %s, expected Wed Dec 4 21:30:00 2024 +0530", got)
+	}
+	if got := FormatGitRaw(z); got != "1733328000 +0530" {
+		t.Errorf("FormatGitRaw() = %s, expected 1733328000 +0530", got)
+	}
+
+	back, err := ParseGitDate(FormatGitDate(z))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.Instant != z.Instant || back.Offset() != z.Offset() {
+		t.Errorf("ParseGitDate(FormatGitDate()) = %s, expected %s", back, z)
+	}
+}
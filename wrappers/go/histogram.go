@@ -0,0 +1,100 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// Bucket is one bucket of a histogram: the timestamps counted and the
+// half-open interval they fell in.
+type Bucket struct {
+	Interval
+	Count int
+}
+
+// Histogram counts ts into consecutive buckets of the given width covering
+// span, starting at span.Start. The last bucket is truncated at span.End.
+// Timestamps outside span are ignored, and ts need not be sorted.
+func Histogram(ts Timestamps, span Interval, width Duration) ([]Bucket, error) {
+	if width <= 0 {
+		return nil, errors.New("histogram bucket width must be positive")
+	}
+
+	var buckets []Bucket
+	for start := span.Start; start < span.End; start += Timestamp(width) {
+		end := start + Timestamp(width)
+		if end > span.End || end < start {
+			end = span.End
+		}
+		buckets = append(buckets, Bucket{Interval: Interval{Start: start, End: end}})
+		if end == span.End {
+			break
+		}
+	}
+
+	for _, t := range ts {
+		if span.Contains(t) {
+			buckets[int64(t-span.Start)/int64(width)].Count++
+		}
+	}
+	return buckets, nil
+}
+
+// CalendarHistogram is like Histogram but steps bucket boundaries by a
+// calendar period in loc, so buckets can follow months or local days
+// across daylight-saving changes. To align buckets to calendar units, start
+// span on a boundary, for example with StartOfQuarter, or use
+// WeeklyHistogram for weeks. A nil loc is treated as UTC.
+func CalendarHistogram(ts Timestamps, span Interval, step Period, loc *time.Location) ([]Bucket, error) {
+	// Normalize first so that steps whose parts cancel out, such as one
+	// year less twelve months, are rejected rather than looping forever.
+	step = step.Normalize()
+	if step.Years < 0 || step.Months < 0 || step.Days < 0 || step.IsZero() {
+		return nil, errors.New("histogram period must be positive")
+	}
+
+	return calendarBuckets(ts, span, func(n int) Timestamp {
+		return span.Start.AddPeriod(scalePeriod(step, n), loc)
+	}), nil
+}
+
+// WeeklyHistogram counts ts into calendar weeks in loc, each beginning at
+// the start of the WeekStart day, as StartOfWeek returns. The first and last buckets are truncated
+// to span when it does not start or end on a week boundary. A nil loc is
+// treated as UTC.
+func WeeklyHistogram(ts Timestamps, span Interval, loc *time.Location) []Bucket {
+	return calendarBuckets(ts, span, func(n int) Timestamp {
+		return span.Start.weekBoundary(n, loc)
+	})
+}
+
+// calendarBuckets counts ts into buckets of span. The nth bucket ends at
+// boundary(n), for n from 1, which must increase past span.Start.
+func calendarBuckets(ts Timestamps, span Interval, boundary func(n int) Timestamp) []Bucket {
+	var buckets []Bucket
+	for i, start := 0, span.Start; start < span.End; i++ {
+		end := boundary(i + 1)
+		if end > span.End {
+			end = span.End
+		}
+		buckets = append(buckets, Bucket{Interval: Interval{Start: start, End: end}})
+		start = end
+	}
+
+	for _, t := range ts {
+		if !span.Contains(t) {
+			continue
+		}
+		i := sort.Search(len(buckets), func(i int) bool { return buckets[i].End > t })
+		buckets[i].Count++
+	}
+	return buckets
+}
+
+// scalePeriod returns p multiplied by n. Boundaries are computed from the
+// span start rather than by repeated addition so that month-end clamping
+// does not accumulate.
+func scalePeriod(p Period, n int) Period {
+	return Period{Years: p.Years * n, Months: p.Months * n, Days: p.Days * n}
+}
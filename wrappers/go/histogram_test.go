@@ -0,0 +1,158 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHistogram(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	span := Interval{Start: start, End: start + Timestamp(25*Minute)}
+	ts := Timestamps{
+		start,
+		start + Timestamp(9*Minute),
+		start + Timestamp(10*Minute),
+		start + Timestamp(24*Minute),
+		start + Timestamp(25*Minute),
+		start - 1,
+	}
+
+	buckets, err := Histogram(ts, span, 10*Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := []int{2, 1, 1}
+	if len(buckets) != len(counts) {
+		t.Fatalf("Histogram() returned %d buckets, expected %d", len(buckets), len(counts))
+	}
+	for i, b := range buckets {
+		if b.Count != counts[i] {
+			t.Errorf("bucket %d count = %d, expected %d", i, b.Count, counts[i])
+		}
+	}
+	if last := buckets[2].String(); last != "2024-12-14T12:20:00Z/2024-12-14T12:25:00Z" {
+		t.Errorf("last bucket = %s", last)
+	}
+
+	if _, err := Histogram(ts, span, 0); err == nil {
+		t.Error("Histogram() with zero width succeeded")
+	}
+}
+
+func TestCalendarHistogram(t *testing.T) {
+	span := Interval{Start: mustParse(t, "2024-01-31T00:00:00Z"), End: mustParse(t, "2024-04-30T00:00:00Z")}
+	ts := Timestamps{
+		mustParse(t, "2024-02-15T00:00:00Z"),
+		mustParse(t, "2024-02-29T12:00:00Z"),
+		mustParse(t, "2024-03-31T00:00:00Z"),
+	}
+
+	buckets, err := CalendarHistogram(ts, span, Period{Months: 1}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		interval string
+		count    int
+	}{
+		{"2024-01-31T00:00:00Z/2024-02-29T00:00:00Z", 1},
+		{"2024-02-29T00:00:00Z/2024-03-31T00:00:00Z", 1},
+		{"2024-03-31T00:00:00Z/2024-04-30T00:00:00Z", 1},
+	}
+	if len(buckets) != len(expected) {
+		t.Fatalf("CalendarHistogram() = %v", buckets)
+	}
+	for i, b := range buckets {
+		if b.String() != expected[i].interval || b.Count != expected[i].count {
+			t.Errorf("bucket %d = %s (%d), expected %s (%d)", i, b, b.Count, expected[i].interval, expected[i].count)
+		}
+	}
+
+	if _, err := CalendarHistogram(ts, span, Period{}, nil); err == nil {
+		t.Error("CalendarHistogram() with zero period succeeded")
+	}
+	if _, err := CalendarHistogram(ts, span, Period{Months: -1}, nil); err == nil {
+		t.Error("CalendarHistogram() with negative period succeeded")
+	}
+	for _, step := range []Period{{Years: 1, Months: -12}, {Years: -1, Months: 12}, {Years: 1, Months: -13, Days: 40}} {
+		if _, err := CalendarHistogram(ts, span, step, nil); err == nil {
+			t.Errorf("CalendarHistogram() with period %s succeeded", step)
+		}
+	}
+}
+
+func TestCalendarHistogramDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	span := Interval{Start: mustParse(t, "2024-03-09T05:00:00Z"), End: mustParse(t, "2024-03-12T04:00:00Z")}
+	buckets, err := CalendarHistogram(nil, span, Period{Days: 1}, ny)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buckets) != 3 || buckets[1].Duration() != 23*Hour {
+		t.Errorf("CalendarHistogram() across DST = %v", buckets)
+	}
+}
+
+func TestWeeklyHistogram(t *testing.T) {
+	defer SetWeekStart(DefaultWeekStart)
+	SetWeekStart(time.Sunday)
+
+	// From Wednesday 2024-12-04 to Wednesday 2024-12-18.
+	span := Interval{Start: mustParse(t, "2024-12-04T00:00:00Z"), End: mustParse(t, "2024-12-18T00:00:00Z")}
+	ts := Timestamps{
+		mustParse(t, "2024-12-07T23:00:00Z"),
+		mustParse(t, "2024-12-08T00:00:00Z"),
+		mustParse(t, "2024-12-15T06:00:00Z"),
+		mustParse(t, "2024-12-01T00:00:00Z"),
+	}
+	buckets := WeeklyHistogram(ts, span, nil)
+	expected := []struct {
+		interval string
+		count    int
+	}{
+		{"2024-12-04T00:00:00Z/2024-12-08T00:00:00Z", 1},
+		{"2024-12-08T00:00:00Z/2024-12-15T00:00:00Z", 1},
+		{"2024-12-15T00:00:00Z/2024-12-18T00:00:00Z", 1},
+	}
+	if len(buckets) != len(expected) {
+		t.Fatalf("WeeklyHistogram() = %v", buckets)
+	}
+	for i, b := range buckets {
+		if b.String() != expected[i].interval || b.Count != expected[i].count {
+			t.Errorf("bucket %d = %s (%d), expected %s (%d)", i, b, b.Count, expected[i].interval, expected[i].count)
+		}
+	}
+}
+
+func TestWeeklyHistogramMidnightGap(t *testing.T) {
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	defer SetWeekStart(DefaultWeekStart)
+	SetWeekStart(time.Sunday)
+
+	// Sao Paulo skipped midnight on Sunday 2015-10-18, so that week began
+	// at 03:00 UTC and the next one at 02:00 UTC.
+	span := Interval{Start: mustParse(t, "2015-10-11T03:00:00Z"), End: mustParse(t, "2015-11-01T02:00:00Z")}
+	buckets := WeeklyHistogram(Timestamps{mustParse(t, "2015-10-18T02:30:00Z")}, span, loc)
+	expected := []string{
+		"2015-10-11T03:00:00Z/2015-10-18T03:00:00Z",
+		"2015-10-18T03:00:00Z/2015-10-25T02:00:00Z",
+		"2015-10-25T02:00:00Z/2015-11-01T02:00:00Z",
+	}
+	if len(buckets) != len(expected) {
+		t.Fatalf("WeeklyHistogram() = %v", buckets)
+	}
+	for i, b := range buckets {
+		if b.String() != expected[i] {
+			t.Errorf("bucket %d = %s, expected %s", i, b, expected[i])
+		}
+	}
+	if buckets[0].Count != 1 {
+		t.Errorf("bucket 0 count = %d, expected 1", buckets[0].Count)
+	}
+}
@@ -0,0 +1,79 @@
+package universal_timestamp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// QueryTimestamp is a Timestamp for request-struct fields bound from query
+// strings or form values. It implements encoding.TextUnmarshaler, used by
+// gorilla/schema and gin's form binding, and the UnmarshalParam method
+// that echo and gin look for, both parsing with ParseQueryParam relative
+// to DefaultClock. Timestamp itself implements only UnmarshalParam:
+// TextUnmarshaler would change how encoding/json treats it.
+type QueryTimestamp Timestamp
+
+// Timestamp converts q to a Timestamp.
+func (q QueryTimestamp) Timestamp() Timestamp {
+	return Timestamp(q)
+}
+
+// MarshalText implements encoding.TextMarshaler using Format.
+func (q QueryTimestamp) MarshalText() ([]byte, error) {
+	return []byte(Timestamp(q).Format()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseQueryParam.
+func (q *QueryTimestamp) UnmarshalText(text []byte) error {
+	return (*Timestamp)(q).UnmarshalParam(string(text))
+}
+
+// UnmarshalParam implements the binding interface of echo and gin using
+// ParseQueryParam.
+func (q *QueryTimestamp) UnmarshalParam(param string) error {
+	return (*Timestamp)(q).UnmarshalParam(param)
+}
+
+// UnmarshalParam implements the binding interface of echo and gin, so that
+// plain Timestamp fields bind from query and form values. It parses param
+// with ParseQueryParam relative to DefaultClock.
+func (t *Timestamp) UnmarshalParam(param string) error {
+	ts, err := ParseQueryParam(param, DefaultClock().Now())
+	if err != nil {
+		return err
+	}
+	*t = ts
+	return nil
+}
+
+// ParseQueryParam parses a timestamp given in a URL query or form value:
+// an ISO-8601 instant such as "2024-12-01T00:00:00Z" or one with a numeric
+// offset, "now", or an offset from ref written as a Go duration ("-24h",
+// "+90m") or an ISO-8601 duration ("-P1D", "PT30M"). Calendar parts of an
+// ISO-8601 duration are applied in UTC. A space in place of an offset's
+// "+", left by a query string that did not percent-encode it, is read as
+// "+". Other input returns the error from ParseWithOffset.
+func ParseQueryParam(s string, ref Timestamp) (Timestamp, error) {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, "now") {
+		return ref, nil
+	}
+
+	if unsigned := strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+"); isISODuration(unsigned) {
+		p, d, err := ParseISODuration(s)
+		if err != nil {
+			return 0, fmt.Errorf("%w: query parameter %q", ErrInvalidFormat, s)
+		}
+		return ref.AddPeriod(p, nil) + Timestamp(d), nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return ref + Timestamp(d), nil
+	}
+
+	if n := len(s); n > 6 && s[n-6] == ' ' && s[n-3] == ':' {
+		s = s[:n-6] + "+" + s[n-5:]
+	}
+	ts, _, err := ParseWithOffset(s)
+	return ts, err
+}
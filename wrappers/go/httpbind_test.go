@@ -0,0 +1,73 @@
+package universal_timestamp
+
+import (
+	"encoding"
+	"errors"
+	"net/url"
+	"testing"
+)
+
+func TestParseQueryParam(t *testing.T) {
+	ref := mustParse(t, "2024-12-14T12:00:00Z")
+	cases := []struct {
+		in, expected string
+	}{
+		{"2024-12-01T00:00:00Z", "2024-12-01T00:00:00Z"},
+		{"2024-12-01T01:00:00+01:00", "2024-12-01T00:00:00Z"},
+		// An unencoded "+" arrives as a space.
+		{"2024-12-01T01:00:00 01:00", "2024-12-01T00:00:00Z"},
+		{"now", "2024-12-14T12:00:00Z"},
+		{"-24h", "2024-12-13T12:00:00Z"},
+		{"+1h30m", "2024-12-14T13:30:00Z"},
+		{"-P1M", "2024-11-14T12:00:00Z"},
+		{"PT30M", "2024-12-14T12:30:00Z"},
+	}
+	for _, c := range cases {
+		ts, err := ParseQueryParam(c.in, ref)
+		if err != nil {
+			t.Errorf("ParseQueryParam(%q) error: %v", c.in, err)
+			continue
+		}
+		if got := ts.Format(); got != c.expected {
+			t.Errorf("ParseQueryParam(%q) = %s, expected %s", c.in, got, c.expected)
+		}
+	}
+
+	for _, s := range []string{"", "yesterday", "-", "-P", "2024-12-01"} {
+		if _, err := ParseQueryParam(s, ref); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseQueryParam(%q) error = %v, expected ErrInvalidFormat", s, err)
+		}
+	}
+}
+
+func TestQueryTimestampBinding(t *testing.T) {
+	values, err := url.ParseQuery("since=2024-12-01T00:00:00Z&until=-24h")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var since QueryTimestamp
+	var u encoding.TextUnmarshaler = &since
+	if err := u.UnmarshalText([]byte(values.Get("since"))); err != nil {
+		t.Fatalf("UnmarshalText() error: %v", err)
+	}
+	if got := since.Timestamp().Format(); got != "2024-12-01T00:00:00Z" {
+		t.Errorf("UnmarshalText() = %s, expected 2024-12-01T00:00:00Z", got)
+	}
+	if text, _ := since.MarshalText(); string(text) != "2024-12-01T00:00:00Z" {
+		t.Errorf("MarshalText() = %s, expected 2024-12-01T00:00:00Z", text)
+	}
+
+	var until Timestamp
+	before := SystemClock.Now()
+	if err := until.UnmarshalParam(values.Get("until")); err != nil {
+		t.Fatalf("UnmarshalParam() error: %v", err)
+	}
+	if d := Duration(before - until); d < 24*Hour-Second || d > 24*Hour+Second {
+		t.Errorf("UnmarshalParam(-24h) is %s before now", d)
+	}
+
+	if err := until.UnmarshalParam("soon"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("UnmarshalParam(soon) error = %v, expected ErrInvalidFormat", err)
+	}
+}
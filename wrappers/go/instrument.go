@@ -0,0 +1,137 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"expvar"
+	"sync/atomic"
+)
+
+// Parse failure reasons passed to Metrics.Parse. The C core's error codes
+// map to the matching reason; failures detected before the C core is
+// called, such as an unknown zone abbreviation, have their own.
+const (
+	ReasonInvalidFormat     = "invalid_format"
+	ReasonInvalidDate       = "invalid_date"
+	ReasonOutOfRange        = "out_of_range"
+	ReasonUnsupportedOffset = "unsupported_offset"
+	ReasonFractionTooLong   = "fraction_too_long"
+	ReasonLeapSecond        = "leap_second"
+	ReasonAmbiguousDate     = "ambiguous_date"
+	ReasonUnknownZone       = "unknown_zone"
+)
+
+// Metrics receives counts of the package's parse and C core activity, for
+// example to watch the rate of malformed input in an ingestion pipeline.
+// Methods are called synchronously on the hot path, possibly from many
+// goroutines at once, so implementations must be concurrency-safe and
+// cheap; incrementing a counter is the intended use.
+type Metrics interface {
+	// Parse is called once per timestamp parse with an empty reason on
+	// success, or one of the Reason constants on failure.
+	Parse(reason string)
+	// CgoCall is called once per call into the C core with the name of
+	// the C function, such as "ut_parse_strict" or "ut_format".
+	CgoCall(fn string)
+	// Batch is called by bulk operations such as DecodeSeries with the
+	// operation name and the number of values it handled.
+	Batch(op string, n int)
+}
+
+// metricsHolder boxes the installed Metrics so it can be swapped
+// atomically.
+type metricsHolder struct {
+	m Metrics
+}
+
+// metrics holds the Metrics installed with SetMetrics, or nil.
+var metrics atomic.Pointer[metricsHolder]
+
+// SetMetrics installs m to receive instrumentation events, replacing any
+// previous Metrics. A nil m disables instrumentation, which is the
+// default. It is safe to call concurrently with parsing and formatting.
+func SetMetrics(m Metrics) {
+	if m == nil {
+		metrics.Store(nil)
+		return
+	}
+	metrics.Store(&metricsHolder{m: m})
+}
+
+// ReportBatch reports a bulk operation of n values to the installed
+// Metrics. Subpackages and applications call it for their own batch
+// conversions so they appear alongside the package's.
+func ReportBatch(op string, n int) {
+	if h := metrics.Load(); h != nil {
+		h.m.Batch(op, n)
+	}
+}
+
+// observeParse reports a parse outcome to the installed Metrics.
+func observeParse(reason string) {
+	if h := metrics.Load(); h != nil {
+		h.m.Parse(reason)
+	}
+}
+
+// observeCgo reports a call into the C core to the installed Metrics.
+func observeCgo(fn string) {
+	if h := metrics.Load(); h != nil {
+		h.m.CgoCall(fn)
+	}
+}
+
+// parseFailureReason classifies an error returned before the C core was
+// reached.
+func parseFailureReason(err error) string {
+	switch {
+	case errors.Is(err, ErrFractionTooLong):
+		return ReasonFractionTooLong
+	case errors.Is(err, ErrAmbiguousDate):
+		return ReasonAmbiguousDate
+	case errors.Is(err, ErrUnknownZone):
+		return ReasonUnknownZone
+	case errors.Is(err, ErrOutOfRange):
+		return ReasonOutOfRange
+	}
+	return ReasonInvalidFormat
+}
+
+// ExpvarMetrics is a Metrics that counts events in an expvar.Map, so they
+// are served by the standard /debug/vars handler. Keys are "parse.ok",
+// "parse.failed.<reason>", "cgo.<function>", and "batch.<op>.calls" and
+// "batch.<op>.items".
+type ExpvarMetrics struct {
+	vars *expvar.Map
+}
+
+// NewExpvarMetrics publishes a new expvar.Map under name and returns a
+// Metrics counting into it. Like expvar.Publish, it panics if name is
+// already in use.
+func NewExpvarMetrics(name string) *ExpvarMetrics {
+	return &ExpvarMetrics{vars: expvar.NewMap(name)}
+}
+
+// Map returns the expvar.Map the counters are kept in.
+func (e *ExpvarMetrics) Map() *expvar.Map {
+	return e.vars
+}
+
+// Parse counts a parse success or failure.
+func (e *ExpvarMetrics) Parse(reason string) {
+	if reason == "" {
+		e.vars.Add("parse.ok", 1)
+		return
+	}
+	e.vars.Add("parse.failed."+reason, 1)
+}
+
+// CgoCall counts a call into the C core.
+func (e *ExpvarMetrics) CgoCall(fn string) {
+	e.vars.Add("cgo."+fn, 1)
+}
+
+// Batch counts a bulk operation and the values it handled.
+func (e *ExpvarMetrics) Batch(op string, n int) {
+	e.vars.Add("batch."+op+".calls", 1)
+	e.vars.Add("batch."+op+".items", int64(n))
+}
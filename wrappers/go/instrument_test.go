@@ -0,0 +1,123 @@
+package universal_timestamp
+
+import (
+	"sync"
+	"testing"
+)
+
+type recordingMetrics struct {
+	mu      sync.Mutex
+	parses  map[string]int
+	cgo     map[string]int
+	batches map[string]int
+}
+
+func newRecordingMetrics(t *testing.T) *recordingMetrics {
+	m := &recordingMetrics{parses: map[string]int{}, cgo: map[string]int{}, batches: map[string]int{}}
+	SetMetrics(m)
+	t.Cleanup(func() { SetMetrics(nil) })
+	return m
+}
+
+func (m *recordingMetrics) Parse(reason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.parses[reason]++
+}
+
+func (m *recordingMetrics) CgoCall(fn string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cgo[fn]++
+}
+
+func (m *recordingMetrics) Batch(op string, n int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.batches[op] += n
+}
+
+func TestMetricsParse(t *testing.T) {
+	m := newRecordingMetrics(t)
+
+	inputs := []string{
+		"2024-12-14T12:00:00Z",
+		"2024-12-14T12:00:00.5Z",
+		"2024-13-01T00:00:00Z",
+		"2024-12-14T12:00:00+01:00",
+		"2024-12-14T24:00:00Z",
+		"garbage",
+	}
+	for _, s := range inputs {
+		Parse(s)
+	}
+	Parse("2024-12-14T12:00:00.1234Z", MaxFractionDigits(3))
+	Parse("2024-12-14T12:00:00 XYZ", AllowZoneAbbreviations(nil))
+
+	expected := map[string]int{
+		"":                      2,
+		ReasonInvalidDate:       1,
+		ReasonUnsupportedOffset: 1,
+		ReasonOutOfRange:        1,
+		ReasonInvalidFormat:     1,
+		ReasonFractionTooLong:   1,
+		ReasonUnknownZone:       1,
+	}
+	for reason, n := range expected {
+		if m.parses[reason] != n {
+			t.Errorf("Parse(%q) count = %d, expected %d", reason, m.parses[reason], n)
+		}
+	}
+	if m.cgo["ut_parse_strict"] != len(inputs) {
+		t.Errorf("ut_parse_strict calls = %d, expected %d", m.cgo["ut_parse_strict"], len(inputs))
+	}
+}
+
+func TestMetricsCgoAndBatch(t *testing.T) {
+	m := newRecordingMetrics(t)
+
+	Now()
+	mustParse(t, "2024-12-14T12:00:00Z").Format()
+	if _, err := DecodeSeries(EncodeSeries([]Timestamp{1, 2, 3})); err != nil {
+		t.Fatal(err)
+	}
+	ReportBatch("ingest", 10)
+
+	if m.cgo["ut_now"] != 1 || m.cgo["ut_format"] != 1 {
+		t.Errorf("cgo calls = %v", m.cgo)
+	}
+	if m.batches["AppendSeries"] != 3 || m.batches["DecodeSeries"] != 3 || m.batches["ingest"] != 10 {
+		t.Errorf("batches = %v", m.batches)
+	}
+
+	SetMetrics(nil)
+	Now()
+	if m.cgo["ut_now"] != 1 {
+		t.Errorf("ut_now counted after SetMetrics(nil)")
+	}
+}
+
+func TestExpvarMetrics(t *testing.T) {
+	e := NewExpvarMetrics("universal_timestamp_test")
+	SetMetrics(e)
+	t.Cleanup(func() { SetMetrics(nil) })
+
+	Parse("2024-12-14T12:00:00Z")
+	Parse("2024-02-30T12:00:00Z")
+	ReportBatch("ingest", 4)
+	ReportBatch("ingest", 6)
+
+	expected := map[string]string{
+		"parse.ok":                  "1",
+		"parse.failed.invalid_date": "1",
+		"cgo.ut_parse_strict":       "2",
+		"batch.ingest.calls":        "2",
+		"batch.ingest.items":        "10",
+	}
+	for key, value := range expected {
+		v := e.Map().Get(key)
+		if v == nil || v.String() != value {
+			t.Errorf("%s = %v, expected %s", key, v, value)
+		}
+	}
+}
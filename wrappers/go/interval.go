@@ -0,0 +1,138 @@
+package universal_timestamp
+
+import "strings"
+
+// Interval is a half-open span of time [Start, End).
+type Interval struct {
+	Start Timestamp
+	End   Timestamp
+}
+
+// Duration returns the length of the interval.
+func (i Interval) Duration() Duration {
+	return Duration(i.End - i.Start)
+}
+
+// IsEmpty reports whether the interval contains no instants.
+func (i Interval) IsEmpty() bool {
+	return i.End <= i.Start
+}
+
+// Contains reports whether ts falls within the interval.
+func (i Interval) Contains(ts Timestamp) bool {
+	return ts >= i.Start && ts < i.End
+}
+
+// Overlaps reports whether the two intervals share at least one instant.
+func (i Interval) Overlaps(other Interval) bool {
+	return i.Start < other.End && other.Start < i.End
+}
+
+// String formats the interval in ISO-8601 "start/end" notation.
+func (i Interval) String() string {
+	return i.Start.Format() + "/" + i.End.Format()
+}
+
+// ParseInterval parses an ISO-8601 time interval in "start/end",
+// "start/duration" or "duration/end" notation, such as
+// "2024-12-01T00:00:00Z/P1M" or "PT1H/2024-12-14T12:00:00Z". Durations may
+// combine calendar and clock components ("P1DT12H"); calendar components
+// are applied in UTC. opts are passed on to Parse for the timestamps.
+func ParseInterval(s string, opts ...ParseOption) (Interval, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 {
+		return Interval{}, ErrInvalidFormat
+	}
+
+	var iv Interval
+	switch {
+	case isISODuration(parts[0]) && isISODuration(parts[1]):
+		return Interval{}, ErrInvalidFormat
+	case isISODuration(parts[1]):
+		start, err := Parse(parts[0], opts...)
+		if err != nil {
+			return Interval{}, err
+		}
+		p, d, err := ParseISODuration(parts[1])
+		if err != nil {
+			return Interval{}, err
+		}
+		iv = Interval{Start: start, End: start.AddPeriod(p, nil) + Timestamp(d)}
+	case isISODuration(parts[0]):
+		end, err := Parse(parts[1], opts...)
+		if err != nil {
+			return Interval{}, err
+		}
+		p, d, err := ParseISODuration(parts[0])
+		if err != nil {
+			return Interval{}, err
+		}
+		iv = Interval{Start: (end - Timestamp(d)).AddPeriod(p.Negate(), nil), End: end}
+	default:
+		start, err := Parse(parts[0], opts...)
+		if err != nil {
+			return Interval{}, err
+		}
+		end, err := Parse(parts[1], opts...)
+		if err != nil {
+			return Interval{}, err
+		}
+		iv = Interval{Start: start, End: end}
+	}
+
+	if iv.End < iv.Start {
+		return Interval{}, ErrInvalidFormat
+	}
+	return iv, nil
+}
+
+// isISODuration reports whether s looks like an ISO-8601 duration.
+func isISODuration(s string) bool {
+	return strings.HasPrefix(s, "P") || strings.HasPrefix(s, "p")
+}
+
+// ParseISODuration splits an ISO-8601 duration such as "P1DT12H" into the
+// calendar part, read as by ParsePeriod, and the clock part, read as by
+// ParseDuration. A leading '-' negates both parts and a leading '+' is
+// ignored, so "-P1DT12H" is minus one day and minus twelve hours. Add the
+// result to a timestamp with AddPeriod before adding the Duration.
+func ParseISODuration(s string) (Period, Duration, error) {
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	if !isISODuration(s) {
+		return Period{}, 0, ErrInvalidFormat
+	}
+
+	datePart, timePart := s, ""
+	if i := strings.IndexAny(s, "Tt"); i >= 0 {
+		datePart, timePart = s[:i], s[i+1:]
+		if timePart == "" {
+			return Period{}, 0, ErrInvalidFormat
+		}
+	}
+
+	var p Period
+	if datePart != "P" && datePart != "p" {
+		var err error
+		if p, err = ParsePeriod(datePart); err != nil {
+			return Period{}, 0, err
+		}
+	} else if timePart == "" {
+		return Period{}, 0, ErrInvalidFormat
+	}
+
+	var d Duration
+	if timePart != "" {
+		var err error
+		if d, err = ParseDuration("PT" + timePart); err != nil {
+			return Period{}, 0, err
+		}
+	}
+	if neg {
+		return p.Negate(), -d, nil
+	}
+	return p, d, nil
+}
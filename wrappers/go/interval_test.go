@@ -0,0 +1,77 @@
+package universal_timestamp
+
+import "testing"
+
+func TestIntervalBasics(t *testing.T) {
+	iv := Interval{Start: mustParse(t, "2024-12-14T12:00:00Z"), End: mustParse(t, "2024-12-14T13:00:00Z")}
+
+	if iv.Duration() != Hour {
+		t.Errorf("Duration() = %s", iv.Duration())
+	}
+	if !iv.Contains(iv.Start) || iv.Contains(iv.End) {
+		t.Error("Contains() is not half-open")
+	}
+	if iv.IsEmpty() || !(Interval{}).IsEmpty() {
+		t.Error("IsEmpty() wrong")
+	}
+	other := Interval{Start: iv.End, End: iv.End + Timestamp(Hour)}
+	if iv.Overlaps(other) {
+		t.Error("adjacent intervals reported as overlapping")
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	cases := map[string]string{
+		"2024-12-01T00:00:00Z/2024-12-14T12:00:00Z": "2024-12-01T00:00:00Z/2024-12-14T12:00:00Z",
+		"2024-12-01T00:00:00Z/P1M":                  "2024-12-01T00:00:00Z/2025-01-01T00:00:00Z",
+		"2024-12-01T00:00:00Z/P1DT12H":              "2024-12-01T00:00:00Z/2024-12-02T12:00:00Z",
+		"PT1H/2024-12-14T12:00:00Z":                 "2024-12-14T11:00:00Z/2024-12-14T12:00:00Z",
+		"P1M/2024-03-31T00:00:00Z":                  "2024-02-29T00:00:00Z/2024-03-31T00:00:00Z",
+	}
+	for input, expected := range cases {
+		iv, err := ParseInterval(input)
+		if err != nil {
+			t.Errorf("ParseInterval(%q) failed: %v", input, err)
+			continue
+		}
+		if got := iv.String(); got != expected {
+			t.Errorf("ParseInterval(%q) = %s, expected %s", input, got, expected)
+		}
+	}
+
+	for _, input := range []string{
+		"2024-12-01T00:00:00Z",
+		"P1D/P1D",
+		"2024-12-14T00:00:00Z/2024-12-01T00:00:00Z",
+		"2024-12-01T00:00:00Z/PT",
+		"2024-12-01T00:00:00Z/P",
+	} {
+		if _, err := ParseInterval(input); err == nil {
+			t.Errorf("ParseInterval(%q) succeeded", input)
+		}
+	}
+}
+
+func TestParseISODuration(t *testing.T) {
+	cases := []struct {
+		in     string
+		period Period
+		dur    Duration
+	}{
+		{"P1DT12H", Period{Days: 1}, 12 * Hour},
+		{"-P1DT12H", Period{Days: -1}, -12 * Hour},
+		{"+PT30M", Period{}, 30 * Minute},
+		{"-P1Y2M", Period{Years: -1, Months: -2}, 0},
+	}
+	for _, c := range cases {
+		p, d, err := ParseISODuration(c.in)
+		if err != nil || p != c.period || d != c.dur {
+			t.Errorf("ParseISODuration(%q) = %s, %s, %v; expected %s, %s", c.in, p, d, err, c.period, c.dur)
+		}
+	}
+	for _, s := range []string{"", "-", "P", "PT", "--P1D", "1D", "P1X"} {
+		if _, _, err := ParseISODuration(s); err == nil {
+			t.Errorf("ParseISODuration(%q) succeeded", s)
+		}
+	}
+}
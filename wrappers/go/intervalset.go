@@ -0,0 +1,109 @@
+package universal_timestamp
+
+import "sort"
+
+// IntervalSet is a set of instants held as a sorted union of disjoint,
+// non-adjacent half-open intervals, such as the busy periods of a calendar.
+// The zero value is an empty set.
+type IntervalSet struct {
+	intervals []Interval
+}
+
+// NewIntervalSet returns the union of the given intervals.
+func NewIntervalSet(intervals ...Interval) *IntervalSet {
+	s := &IntervalSet{}
+	for _, iv := range intervals {
+		s.Add(iv)
+	}
+	return s
+}
+
+// Add merges iv into the set, joining any intervals it overlaps or touches.
+// Empty intervals are ignored.
+func (s *IntervalSet) Add(iv Interval) {
+	if iv.IsEmpty() {
+		return
+	}
+	lo := sort.Search(len(s.intervals), func(i int) bool { return s.intervals[i].End >= iv.Start })
+	hi := sort.Search(len(s.intervals), func(i int) bool { return s.intervals[i].Start > iv.End })
+	if lo < hi {
+		if s.intervals[lo].Start < iv.Start {
+			iv.Start = s.intervals[lo].Start
+		}
+		if s.intervals[hi-1].End > iv.End {
+			iv.End = s.intervals[hi-1].End
+		}
+	}
+
+	merged := make([]Interval, 0, len(s.intervals)-(hi-lo)+1)
+	merged = append(merged, s.intervals[:lo]...)
+	merged = append(merged, iv)
+	s.intervals = append(merged, s.intervals[hi:]...)
+}
+
+// Subtract removes the instants of iv from the set, splitting an interval
+// that strictly contains it.
+func (s *IntervalSet) Subtract(iv Interval) {
+	if iv.IsEmpty() {
+		return
+	}
+	lo := sort.Search(len(s.intervals), func(i int) bool { return s.intervals[i].End > iv.Start })
+	hi := sort.Search(len(s.intervals), func(i int) bool { return s.intervals[i].Start >= iv.End })
+	if lo >= hi {
+		return
+	}
+
+	var pieces []Interval
+	if first := s.intervals[lo]; first.Start < iv.Start {
+		pieces = append(pieces, Interval{Start: first.Start, End: iv.Start})
+	}
+	if last := s.intervals[hi-1]; last.End > iv.End {
+		pieces = append(pieces, Interval{Start: iv.End, End: last.End})
+	}
+
+	kept := make([]Interval, 0, len(s.intervals)-(hi-lo)+len(pieces))
+	kept = append(kept, s.intervals[:lo]...)
+	kept = append(kept, pieces...)
+	s.intervals = append(kept, s.intervals[hi:]...)
+}
+
+// Contains reports whether ts falls within any interval of the set.
+func (s *IntervalSet) Contains(ts Timestamp) bool {
+	i := sort.Search(len(s.intervals), func(i int) bool { return s.intervals[i].End > ts })
+	return i < len(s.intervals) && s.intervals[i].Contains(ts)
+}
+
+// Gaps returns the sub-intervals of within that are not in the set, in
+// order: the free windows between busy periods.
+func (s *IntervalSet) Gaps(within Interval) []Interval {
+	if within.IsEmpty() {
+		return nil
+	}
+	var gaps []Interval
+	cursor := within.Start
+	i := sort.Search(len(s.intervals), func(i int) bool { return s.intervals[i].End > within.Start })
+	for ; i < len(s.intervals) && s.intervals[i].Start < within.End; i++ {
+		if s.intervals[i].Start > cursor {
+			gaps = append(gaps, Interval{Start: cursor, End: s.intervals[i].Start})
+		}
+		cursor = s.intervals[i].End
+	}
+	if cursor < within.End {
+		gaps = append(gaps, Interval{Start: cursor, End: within.End})
+	}
+	return gaps
+}
+
+// Intervals returns a copy of the set's disjoint intervals in order.
+func (s *IntervalSet) Intervals() []Interval {
+	return append([]Interval(nil), s.intervals...)
+}
+
+// Duration returns the total length of the set.
+func (s *IntervalSet) Duration() Duration {
+	var total Duration
+	for _, iv := range s.intervals {
+		total += iv.Duration()
+	}
+	return total
+}
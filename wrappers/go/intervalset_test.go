@@ -0,0 +1,73 @@
+package universal_timestamp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func span(start, end int64) Interval {
+	return Interval{Start: Timestamp(start), End: Timestamp(end)}
+}
+
+func TestIntervalSetAdd(t *testing.T) {
+	s := NewIntervalSet(span(10, 20), span(30, 40), span(50, 60))
+	s.Add(span(5, 5))
+	s.Add(span(20, 25))
+	s.Add(span(35, 55))
+	s.Add(span(0, 2))
+
+	expected := []Interval{span(0, 2), span(10, 25), span(30, 60)}
+	if got := s.Intervals(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Intervals() = %v, expected %v", got, expected)
+	}
+	if got := s.Duration(); got != 47 {
+		t.Errorf("Duration() = %d, expected 47", got)
+	}
+}
+
+func TestIntervalSetSubtract(t *testing.T) {
+	s := NewIntervalSet(span(0, 100))
+	s.Subtract(span(40, 60))
+	s.Subtract(span(-10, 10))
+	s.Subtract(span(90, 200))
+	s.Subtract(span(60, 60))
+
+	expected := []Interval{span(10, 40), span(60, 90)}
+	if got := s.Intervals(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Intervals() = %v, expected %v", got, expected)
+	}
+
+	s.Subtract(span(0, 100))
+	if got := s.Intervals(); len(got) != 0 {
+		t.Errorf("Intervals() = %v, expected empty", got)
+	}
+}
+
+func TestIntervalSetContains(t *testing.T) {
+	var s IntervalSet
+	s.Add(span(10, 20))
+	s.Add(span(30, 40))
+	tests := map[int64]bool{9: false, 10: true, 19: true, 20: false, 30: true, 40: false}
+	for ts, expected := range tests {
+		if got := s.Contains(Timestamp(ts)); got != expected {
+			t.Errorf("Contains(%d) = %v, expected %v", ts, got, expected)
+		}
+	}
+}
+
+func TestIntervalSetGaps(t *testing.T) {
+	busy := NewIntervalSet(span(10, 20), span(30, 40))
+
+	expected := []Interval{span(0, 10), span(20, 30), span(40, 50)}
+	if got := busy.Gaps(span(0, 50)); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Gaps(0, 50) = %v, expected %v", got, expected)
+	}
+
+	expected = []Interval{span(20, 30)}
+	if got := busy.Gaps(span(15, 35)); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Gaps(15, 35) = %v, expected %v", got, expected)
+	}
+	if got := busy.Gaps(span(12, 18)); got != nil {
+		t.Errorf("Gaps(12, 18) = %v, expected none", got)
+	}
+}
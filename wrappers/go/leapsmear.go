@@ -0,0 +1,98 @@
+package universal_timestamp
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// leapSecondDays lists the UTC days that ended with an inserted leap
+// second (23:59:60), as published by the IERS. No leap second has been
+// announced since 2016.
+var leapSecondDays = [][3]int{
+	{1972, 6, 30}, {1972, 12, 31}, {1973, 12, 31}, {1974, 12, 31},
+	{1975, 12, 31}, {1976, 12, 31}, {1977, 12, 31}, {1978, 12, 31},
+	{1979, 12, 31}, {1981, 6, 30}, {1982, 6, 30}, {1983, 6, 30},
+	{1985, 6, 30}, {1987, 12, 31}, {1989, 12, 31}, {1990, 12, 31},
+	{1992, 6, 30}, {1993, 6, 30}, {1994, 6, 30}, {1995, 12, 31},
+	{1997, 6, 30}, {1998, 12, 31}, {2005, 12, 31}, {2008, 12, 31},
+	{2012, 6, 30}, {2015, 6, 30}, {2016, 12, 31},
+}
+
+// builtinLeapSeconds holds, for each leap second in leapSecondDays, the
+// Unix time of the midnight that follows it.
+var builtinLeapSeconds = func() []Timestamp {
+	ls := make([]Timestamp, len(leapSecondDays))
+	for i, d := range leapSecondDays {
+		ls[i] = FromTime(time.Date(d[0], time.Month(d[1]), d[2]+1, 0, 0, 0, 0, time.UTC))
+	}
+	return ls
+}()
+
+// leapSeconds holds the leap-second table in use: builtinLeapSeconds, or
+// one loaded by Init.
+var leapSeconds atomic.Pointer[[]Timestamp]
+
+func init() {
+	leapSeconds.Store(&builtinLeapSeconds)
+}
+
+// A smear window spans 86400 seconds of Unix time but 86401 seconds of
+// elapsed time.
+const (
+	smearWindowSeconds  = 86400
+	smearElapsedSeconds = 86401
+	smearHalfSpan       = int64(12 * Hour)
+)
+
+// smearWindowFor returns the midnight of the leap second whose smear
+// window, noon to noon, contains ts.
+func smearWindowFor(ts Timestamp) (Timestamp, bool) {
+	for _, l := range *leapSeconds.Load() {
+		if ts >= l-Timestamp(smearHalfSpan) && ts < l+Timestamp(smearHalfSpan) {
+			return l, true
+		}
+	}
+	return 0, false
+}
+
+// ToSmeared converts a UTC timestamp to the reading of a clock that
+// follows a 24-hour linear leap smear, as Google's public NTP servers do:
+// the clock runs slower by 1/86400 from noon before each leap second to
+// noon after it, so it never shows 23:59:60. Outside those windows the
+// two agree.
+func ToSmeared(utc Timestamp) Timestamp {
+	l, ok := smearWindowFor(utc)
+	if !ok {
+		return utc
+	}
+	start := l - Timestamp(smearHalfSpan)
+	elapsed := int64(utc - start)
+	if utc >= l {
+		elapsed += int64(Second)
+	}
+	return start + Timestamp(elapsed*smearWindowSeconds/smearElapsedSeconds)
+}
+
+// FromSmeared converts the reading of a leap-smearing clock back to UTC.
+// A reading taken during the inserted leap second itself has no Unix-time
+// equivalent; FromSmeared then returns the following midnight and reports
+// inLeap as true. Round trips through ToSmeared are exact to within a
+// nanosecond.
+func FromSmeared(smeared Timestamp) (utc Timestamp, inLeap bool) {
+	l, ok := smearWindowFor(smeared)
+	if !ok {
+		return smeared, false
+	}
+	start := l - Timestamp(smearHalfSpan)
+	s := int64(smeared - start)
+	// Smallest elapsed time that smears to s.
+	elapsed := (s*smearElapsedSeconds + smearWindowSeconds - 1) / smearWindowSeconds
+
+	switch {
+	case elapsed < smearHalfSpan:
+		return start + Timestamp(elapsed), false
+	case elapsed < smearHalfSpan+int64(Second):
+		return l, true
+	}
+	return start + Timestamp(elapsed-int64(Second)), false
+}
@@ -0,0 +1,41 @@
+package universal_timestamp
+
+import "testing"
+
+func TestLeapSmear(t *testing.T) {
+	leap := mustParse(t, "2017-01-01T00:00:00Z")
+
+	cases := []struct {
+		utc, smeared string
+	}{
+		{"2016-12-31T11:59:59Z", "2016-12-31T11:59:59Z"},
+		{"2016-12-31T12:00:00Z", "2016-12-31T12:00:00Z"},
+		// Just after the leap second the smear has absorbed only half of
+		// it, so the smeared clock reads half a second ahead of UTC.
+		{"2017-01-01T00:00:00Z", "2017-01-01T00:00:00.499994213Z"},
+		{"2017-01-01T12:00:00Z", "2017-01-01T12:00:00Z"},
+		{"2020-06-01T00:00:00Z", "2020-06-01T00:00:00Z"},
+	}
+	for _, c := range cases {
+		if got := ToSmeared(mustParse(t, c.utc)).Format(); got != c.smeared {
+			t.Errorf("ToSmeared(%s) = %s, expected %s", c.utc, got, c.smeared)
+		}
+	}
+
+	for _, offset := range []Duration{-12 * Hour, -6 * Hour, -Second, -1, 0, 1, Second, 6 * Hour, 12*Hour - 1} {
+		utc := leap + Timestamp(offset)
+		back, inLeap := FromSmeared(ToSmeared(utc))
+		if inLeap || !back.EqualWithin(utc, 1) {
+			t.Errorf("FromSmeared(ToSmeared(%s)) = %s, %v", utc.Format(), back.Format(), inLeap)
+		}
+	}
+}
+
+func TestFromSmearedDuringLeapSecond(t *testing.T) {
+	leap := mustParse(t, "2017-01-01T00:00:00Z")
+	// The smeared clock shows 23:59:59.6 partway through 23:59:60.
+	utc, inLeap := FromSmeared(leap - Timestamp(400*Millisecond))
+	if !inLeap || utc != leap {
+		t.Errorf("FromSmeared() = %s, %v; expected %s, true", utc.Format(), inLeap, leap.Format())
+	}
+}
@@ -0,0 +1,190 @@
+package universal_timestamp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// InitConfig is the process-wide configuration applied by Init.
+type InitConfig struct {
+	// Config becomes the package defaults, as with SetDefaults.
+	Config Config
+	// ClockSource selects the clock behind DefaultClock, which components
+	// such as Ticker, RateLimiter and Deadline use when given a nil Clock.
+	// The zero value is ClockRealtime. Other sources do not count from the
+	// Unix epoch, so choose them only when every such component measures
+	// intervals rather than formatting times.
+	ClockSource ClockSource
+	// LeapSecondsFile is the path of an IERS or IANA leap-seconds.list
+	// file to use for leap smearing in place of the built-in table, so a
+	// newly announced leap second can be picked up without a rebuild.
+	// Empty keeps the built-in table.
+	LeapSecondsFile string
+}
+
+// clockHolder boxes the default Clock so it can be swapped atomically.
+type clockHolder struct {
+	c Clock
+}
+
+var (
+	// lifecycle serializes Init and Close.
+	lifecycle   sync.Mutex
+	initialized bool
+	// defaultClock holds the Clock installed by Init, or nil for
+	// SystemClock.
+	defaultClock atomic.Pointer[clockHolder]
+)
+
+// ntpEpochOffset is the number of seconds from 1900-01-01, the NTP epoch
+// used by leap-seconds.list, to the Unix epoch.
+const ntpEpochOffset = 2208988800
+
+// Init validates cfg and applies it to the Go package in one step: the
+// defaults used by Parse and Format, the clock behind DefaultClock and the
+// leap-second table. The C core keeps no configuration of its own, so
+// nothing is passed to it; Init only decides which core functions the
+// package calls, such as the clock source read by DefaultClock. Nothing is changed if any part is invalid; the
+// error then wraps ErrInvalidPrecision or ErrInvalidFormat for a bad
+// Config, ErrUnsupportedClock for an unavailable clock source, or the
+// error from reading LeapSecondsFile. Init may be called again only after
+// Close; until then it returns ErrAlreadyInitialized.
+//
+// Without Init the package uses the zero Config, the real-time clock and
+// the built-in leap-second table, and the individual setters such as
+// SetDefaults remain available either way.
+func Init(cfg InitConfig) error {
+	lifecycle.Lock()
+	defer lifecycle.Unlock()
+	if initialized {
+		return fmt.Errorf("%w: Init called twice without Close", ErrAlreadyInitialized)
+	}
+
+	if err := validateConfig(cfg.Config); err != nil {
+		return err
+	}
+	clock, err := NewClock(cfg.ClockSource)
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, cfg.ClockSource)
+	}
+	var table []Timestamp
+	if cfg.LeapSecondsFile != "" {
+		if table, err = loadLeapSeconds(cfg.LeapSecondsFile); err != nil {
+			return err
+		}
+	}
+
+	SetDefaults(cfg.Config)
+	defaultClock.Store(&clockHolder{c: clock})
+	if table != nil {
+		leapSeconds.Store(&table)
+	}
+	initialized = true
+	return nil
+}
+
+// Close stops the background goroutine that refreshes NowCoarse, which a
+// later call to NowCoarse starts again, and restores the configuration
+// Init replaced: the zero Config, SystemClock as DefaultClock and the
+// built-in leap-second table. Close may be called without Init, to stop
+// the goroutine only.
+func Close() {
+	lifecycle.Lock()
+	defer lifecycle.Unlock()
+	stopCoarseClock()
+	if !initialized {
+		return
+	}
+	SetDefaults(Config{})
+	defaultClock.Store(nil)
+	leapSeconds.Store(&builtinLeapSeconds)
+	initialized = false
+}
+
+// DefaultClock returns the Clock used when a nil Clock is given: the clock
+// selected by Init, or SystemClock.
+func DefaultClock() Clock {
+	if h := defaultClock.Load(); h != nil {
+		return h.c
+	}
+	return SystemClock
+}
+
+// validateConfig reports whether every field of cfg is in range.
+func validateConfig(cfg Config) error {
+	switch {
+	case cfg.Precision < PrecisionSeconds || cfg.Precision > 9:
+		return fmt.Errorf("%w: Config.Precision %d", ErrInvalidPrecision, cfg.Precision)
+	case cfg.OffsetStyle != OffsetZ && cfg.OffsetStyle != OffsetNumeric:
+		return fmt.Errorf("%w: Config.OffsetStyle %d", ErrInvalidFormat, cfg.OffsetStyle)
+	case cfg.Rounding < RoundFloor || cfg.Rounding > RoundHalfEven:
+		return fmt.Errorf("%w: Config.Rounding %s", ErrInvalidFormat, cfg.Rounding)
+	}
+	return nil
+}
+
+// loadLeapSeconds reads the leap-second table from a leap-seconds.list
+// file.
+func loadLeapSeconds(path string) ([]Timestamp, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	table, err := parseLeapSeconds(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return table, nil
+}
+
+// parseLeapSeconds parses the leap-seconds.list format: comment lines
+// starting with "#", and data lines holding an NTP timestamp and the
+// TAI-UTC offset that takes effect at it. Every entry after the first,
+// which only sets the initial offset in 1972, marks a leap second inserted
+// just before that instant.
+func parseLeapSeconds(r io.Reader) ([]Timestamp, error) {
+	var table []Timestamp
+	entries := 0
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		text := sc.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%w: line %d of leap-second table", ErrInvalidFormat, line)
+		}
+		ntp, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d of leap-second table", ErrInvalidFormat, line)
+		}
+		if _, err := strconv.Atoi(fields[1]); err != nil {
+			return nil, fmt.Errorf("%w: line %d of leap-second table", ErrInvalidFormat, line)
+		}
+		if entries++; entries > 1 {
+			table = append(table, Timestamp((ntp-ntpEpochOffset)*int64(Second)))
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if entries == 0 {
+		return nil, fmt.Errorf("%w: empty leap-second table", ErrInvalidFormat)
+	}
+	if !sort.SliceIsSorted(table, func(i, j int) bool { return table[i] < table[j] }) {
+		return nil, fmt.Errorf("%w: leap-second table is not in order", ErrInvalidFormat)
+	}
+	return table, nil
+}
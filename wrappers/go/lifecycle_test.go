@@ -0,0 +1,131 @@
+package universal_timestamp
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInitClose(t *testing.T) {
+	defer Close()
+
+	cfg := Config{Precision: 3, OffsetStyle: OffsetNumeric}
+	if err := Init(InitConfig{Config: cfg}); err != nil {
+		t.Fatalf("Init() error: %v", err)
+	}
+	if got := mustParse(t, "2024-12-14T12:00:00.123456Z").Format(); got != "2024-12-14T12:00:00.123+00:00" {
+		t.Errorf("Format() after Init = %s", got)
+	}
+	if DefaultClock() != SystemClock {
+		t.Errorf("DefaultClock() after Init is not SystemClock")
+	}
+	if err := Init(InitConfig{}); !errors.Is(err, ErrAlreadyInitialized) {
+		t.Errorf("second Init() error = %v, expected ErrAlreadyInitialized", err)
+	}
+
+	Close()
+	if got := mustParse(t, "2024-12-14T12:00:00.123456Z").Format(); got != "2024-12-14T12:00:00.123456Z" {
+		t.Errorf("Format() after Close = %s", got)
+	}
+	Close()
+	if err := Init(InitConfig{}); err != nil {
+		t.Errorf("Init() after Close error: %v", err)
+	}
+}
+
+func TestInitInvalid(t *testing.T) {
+	defer Close()
+
+	cases := []struct {
+		cfg  InitConfig
+		want error
+	}{
+		{InitConfig{Config: Config{Precision: 10}}, ErrInvalidPrecision},
+		{InitConfig{Config: Config{OffsetStyle: 7}}, ErrInvalidFormat},
+		{InitConfig{Config: Config{Rounding: 9}}, ErrInvalidFormat},
+		{InitConfig{LeapSecondsFile: filepath.Join(t.TempDir(), "missing")}, os.ErrNotExist},
+	}
+	for _, c := range cases {
+		if err := Init(c.cfg); !errors.Is(err, c.want) {
+			t.Errorf("Init(%+v) error = %v, expected %v", c.cfg, err, c.want)
+		}
+	}
+
+	// A failed Init changes nothing and leaves Init available.
+	if err := Init(InitConfig{Config: Config{Precision: 3, Rounding: 9}}); err == nil {
+		t.Fatal("Init() with invalid Rounding succeeded")
+	}
+	if got := Defaults().Precision; got != 0 {
+		t.Errorf("Defaults().Precision after failed Init = %d", got)
+	}
+	if err := Init(InitConfig{}); err != nil {
+		t.Errorf("Init() after failed Init error: %v", err)
+	}
+}
+
+func TestInitLeapSecondsFile(t *testing.T) {
+	defer Close()
+
+	// The 1972 baseline, and a hypothetical leap second at the end of 2029
+	// in place of the real ones.
+	path := filepath.Join(t.TempDir(), "leap-seconds.list")
+	list := "#\tUpdated through 28 June 2029\n" +
+		"#$\t 3945196800\n" +
+		"2272060800\t10\t# 1 Jan 1972\n" +
+		"4102444800\t11\t# 1 Jan 2030\n"
+	if err := os.WriteFile(path, []byte(list), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Init(InitConfig{LeapSecondsFile: path}); err != nil {
+		t.Fatalf("Init() error: %v", err)
+	}
+
+	noon := mustParse(t, "2029-12-31T12:00:00Z")
+	if got := ToSmeared(noon + Timestamp(Hour)); got == noon+Timestamp(Hour) {
+		t.Errorf("ToSmeared() ignores the loaded leap second")
+	}
+	leap2016 := mustParse(t, "2017-01-01T00:00:00Z")
+	if got := ToSmeared(leap2016); got != leap2016 {
+		t.Errorf("ToSmeared(%s) = %s, expected no smear", leap2016.Format(), got.Format())
+	}
+
+	Close()
+	if got := ToSmeared(leap2016); got == leap2016 {
+		t.Errorf("ToSmeared() after Close does not use the built-in table")
+	}
+}
+
+func TestParseLeapSecondsInvalid(t *testing.T) {
+	for _, list := range []string{
+		"",
+		"# comments only\n",
+		"2272060800\n",
+		"2272060800\tten\n",
+		"2272060800\t10\n3692217600\t36\n3644697600\t35\n",
+	} {
+		if _, err := parseLeapSeconds(strings.NewReader(list)); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("parseLeapSeconds(%q) error = %v, expected ErrInvalidFormat", list, err)
+		}
+	}
+}
+
+func TestDefaultClockLookedUpAtCallTime(t *testing.T) {
+	manual := NewManualClock(mustParse(t, "2200-01-01T00:00:00Z"))
+	defaultClock.Store(&clockHolder{c: manual})
+	defer defaultClock.Store(nil)
+
+	if got := NowInterval().Start; got != manual.Now() {
+		t.Errorf("NowInterval() = %s, expected %s", got.Format(), manual.Now().Format())
+	}
+	// ts is decades away in real time but past on the manual clock.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	start := time.Now()
+	if err := SleepUntil(ctx, manual.Now()-Timestamp(Hour)); err != nil || time.Since(start) > 100*time.Millisecond {
+		t.Errorf("SleepUntil() = %v after %s, expected an immediate return", err, time.Since(start))
+	}
+}
@@ -0,0 +1,114 @@
+package universal_timestamp
+
+import "container/heap"
+
+// Iterator yields values one at a time. Next returns false once the
+// iterator is exhausted.
+type Iterator[T any] interface {
+	Next() (T, bool)
+}
+
+// SliceIterator returns an Iterator over the elements of s.
+func SliceIterator[T any](s []T) Iterator[T] {
+	return &sliceIterator[T]{s: s}
+}
+
+// sliceIterator yields the elements of a slice in order.
+type sliceIterator[T any] struct {
+	s []T
+}
+
+func (it *sliceIterator[T]) Next() (T, bool) {
+	var zero T
+	if len(it.s) == 0 {
+		return zero, false
+	}
+	v := it.s[0]
+	it.s = it.s[1:]
+	return v, true
+}
+
+// Collect drains it into a slice.
+func Collect[T any](it Iterator[T]) []T {
+	var out []T
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		out = append(out, v)
+	}
+	return out
+}
+
+// Merge returns an Iterator yielding the values of all the given iterators
+// in chronological order of key, for example to interleave several log
+// files by timestamp. Each input must already be sorted by key. Values with
+// equal keys are yielded in the order of the iterators that produced them,
+// so the merge is stable. Inputs are read lazily, one value ahead.
+func Merge[T any](key func(T) Timestamp, its ...Iterator[T]) Iterator[T] {
+	m := &merger[T]{key: key}
+	for i, it := range its {
+		if v, ok := it.Next(); ok {
+			m.heads = append(m.heads, mergeHead[T]{value: v, at: key(v), source: i, it: it})
+		}
+	}
+	heap.Init(m)
+	return m
+}
+
+// MergeTimestamps merges sorted timestamp iterators into one sorted stream.
+func MergeTimestamps(its ...Iterator[Timestamp]) Iterator[Timestamp] {
+	return Merge(func(ts Timestamp) Timestamp { return ts }, its...)
+}
+
+// mergeHead is the next unconsumed value of one input.
+type mergeHead[T any] struct {
+	value  T
+	at     Timestamp
+	source int
+	it     Iterator[T]
+}
+
+// merger is a min-heap of input heads ordered by key, then input index.
+type merger[T any] struct {
+	key   func(T) Timestamp
+	heads []mergeHead[T]
+}
+
+func (m *merger[T]) Next() (T, bool) {
+	if len(m.heads) == 0 {
+		var zero T
+		return zero, false
+	}
+	head := &m.heads[0]
+	v := head.value
+	if next, ok := head.it.Next(); ok {
+		head.value, head.at = next, m.key(next)
+		heap.Fix(m, 0)
+	} else {
+		heap.Pop(m)
+	}
+	return v, true
+}
+
+func (m *merger[T]) Len() int {
+	return len(m.heads)
+}
+
+func (m *merger[T]) Less(i, j int) bool {
+	if m.heads[i].at != m.heads[j].at {
+		return m.heads[i].at < m.heads[j].at
+	}
+	return m.heads[i].source < m.heads[j].source
+}
+
+func (m *merger[T]) Swap(i, j int) {
+	m.heads[i], m.heads[j] = m.heads[j], m.heads[i]
+}
+
+func (m *merger[T]) Push(x any) {
+	m.heads = append(m.heads, x.(mergeHead[T]))
+}
+
+func (m *merger[T]) Pop() any {
+	last := m.heads[len(m.heads)-1]
+	m.heads = m.heads[:len(m.heads)-1]
+	return last
+}
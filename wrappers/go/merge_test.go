@@ -0,0 +1,46 @@
+package universal_timestamp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeTimestamps(t *testing.T) {
+	merged := Collect(MergeTimestamps(
+		SliceIterator([]Timestamp{1, 4, 7}),
+		SliceIterator([]Timestamp{}),
+		SliceIterator([]Timestamp{2, 2, 8, 9}),
+		SliceIterator([]Timestamp{3, 5}),
+	))
+	expected := []Timestamp{1, 2, 2, 3, 4, 5, 7, 8, 9}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf("MergeTimestamps() = %v, expected %v", merged, expected)
+	}
+
+	if got := Collect(MergeTimestamps()); got != nil {
+		t.Errorf("MergeTimestamps() with no inputs = %v, expected nil", got)
+	}
+}
+
+func TestMergeStable(t *testing.T) {
+	type record struct {
+		at   Timestamp
+		line string
+	}
+	key := func(r record) Timestamp { return r.at }
+	a := SliceIterator([]record{{1, "a1"}, {5, "a5"}})
+	b := SliceIterator([]record{{1, "b1"}, {5, "b5"}, {6, "b6"}})
+
+	var lines []string
+	it := Merge(key, a, b)
+	for r, ok := it.Next(); ok; r, ok = it.Next() {
+		lines = append(lines, r.line)
+	}
+	expected := []string{"a1", "b1", "a5", "b5", "b6"}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("Merge() = %v, expected %v", lines, expected)
+	}
+	if _, ok := it.Next(); ok {
+		t.Error("Next() after exhaustion should return false")
+	}
+}
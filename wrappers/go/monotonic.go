@@ -0,0 +1,96 @@
+package universal_timestamp
+
+// MonotonicViolation classifies an out-of-order timestamp reported by a
+// MonotonicChecker.
+type MonotonicViolation int
+
+const (
+	// Regression means the timestamp is earlier than the latest one seen.
+	Regression MonotonicViolation = iota + 1
+	// Duplicate means the timestamp equals the latest one seen.
+	Duplicate
+	// Jump means the timestamp is later than the latest one seen by more
+	// than the checker's MaxJump.
+	Jump
+)
+
+// String returns the name of the violation.
+func (v MonotonicViolation) String() string {
+	switch v {
+	case Regression:
+		return "regression"
+	case Duplicate:
+		return "duplicate"
+	case Jump:
+		return "jump"
+	}
+	return "unknown"
+}
+
+// MonotonicError describes a timestamp rejected by a MonotonicChecker.
+type MonotonicError struct {
+	Kind     MonotonicViolation
+	Previous Timestamp
+	Current  Timestamp
+}
+
+// Error implements the error interface.
+func (e *MonotonicError) Error() string {
+	return "timestamp " + e.Kind.String() + ": " + e.Current.Format() +
+		" after " + e.Previous.Format()
+}
+
+// MonotonicChecker validates that a stream of timestamps is strictly
+// increasing, for rejecting or quarantining out-of-order data at
+// ingestion. It is not safe for concurrent use.
+type MonotonicChecker struct {
+	// MaxJump is the largest allowed step forward. Zero disables jump
+	// detection.
+	MaxJump Duration
+
+	last    Timestamp
+	started bool
+}
+
+// NewMonotonicChecker returns a MonotonicChecker that reports forward
+// steps larger than maxJump. A zero maxJump disables jump detection.
+func NewMonotonicChecker(maxJump Duration) *MonotonicChecker {
+	return &MonotonicChecker{MaxJump: maxJump}
+}
+
+// Check validates the next timestamp of the stream, returning a
+// *MonotonicError if it is a regression, a duplicate or a jump. Regressions
+// and duplicates leave the checker's position unchanged, so a single bad
+// record does not mask later ones; a jump is reported but accepted as the
+// new position.
+func (c *MonotonicChecker) Check(ts Timestamp) error {
+	if !c.started {
+		c.last, c.started = ts, true
+		return nil
+	}
+
+	prev := c.last
+	switch {
+	case ts < prev:
+		return &MonotonicError{Kind: Regression, Previous: prev, Current: ts}
+	case ts == prev:
+		return &MonotonicError{Kind: Duplicate, Previous: prev, Current: ts}
+	}
+
+	c.last = ts
+	if c.MaxJump > 0 && Duration(ts-prev) > c.MaxJump {
+		return &MonotonicError{Kind: Jump, Previous: prev, Current: ts}
+	}
+	return nil
+}
+
+// Last returns the latest accepted timestamp, and false if none has been
+// checked yet.
+func (c *MonotonicChecker) Last() (Timestamp, bool) {
+	return c.last, c.started
+}
+
+// Reset forgets the stream position.
+func (c *MonotonicChecker) Reset() {
+	c.last, c.started = 0, false
+}
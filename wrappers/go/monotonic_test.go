@@ -0,0 +1,54 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMonotonicChecker(t *testing.T) {
+	base := mustParse(t, "2024-12-14T12:00:00Z")
+	at := func(s int64) Timestamp { return base + Timestamp(s)*Timestamp(Second) }
+
+	c := NewMonotonicChecker(Minute)
+	steps := []struct {
+		ts   Timestamp
+		kind MonotonicViolation
+	}{
+		{at(0), 0},
+		{at(1), 0},
+		{at(1), Duplicate},
+		{at(0), Regression},
+		{at(2), 0},
+		{at(200), Jump},
+		{at(201), 0},
+	}
+	for i, s := range steps {
+		err := c.Check(s.ts)
+		if s.kind == 0 {
+			if err != nil {
+				t.Errorf("step %d: Check() = %v", i, err)
+			}
+			continue
+		}
+		var me *MonotonicError
+		if !errors.As(err, &me) || me.Kind != s.kind {
+			t.Errorf("step %d: Check() = %v, expected %s", i, err, s.kind)
+		}
+	}
+
+	if last, ok := c.Last(); !ok || last != at(201) {
+		t.Errorf("Last() = %s, %v", last.Format(), ok)
+	}
+	c.Reset()
+	if _, ok := c.Last(); ok {
+		t.Error("Last() reported a position after Reset")
+	}
+}
+
+func TestMonotonicErrorMessage(t *testing.T) {
+	err := &MonotonicError{Kind: Regression, Previous: mustParse(t, "2024-12-14T12:00:01Z"), Current: mustParse(t, "2024-12-14T12:00:00Z")}
+	expected := "timestamp regression: 2024-12-14T12:00:00Z after 2024-12-14T12:00:01Z"
+	if err.Error() != expected {
+		t.Errorf("Error() = %q, expected %q", err.Error(), expected)
+	}
+}
@@ -0,0 +1,62 @@
+package universal_timestamp
+
+import "time"
+
+// IsLeapYear reports whether year is a leap year in the proleptic
+// Gregorian calendar.
+func IsLeapYear(year int) bool {
+	return (year%4 == 0 && year%100 != 0) || year%400 == 0
+}
+
+// DaysInMonth returns the number of days in month of year in the proleptic
+// Gregorian calendar.
+func DaysInMonth(year int, month time.Month) int {
+	switch month {
+	case time.February:
+		if IsLeapYear(year) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	default:
+		return 31
+	}
+}
+
+// IsLeapYear reports whether the timestamp falls in a leap year as
+// observed in loc. A nil loc is treated as UTC.
+func (t Timestamp) IsLeapYear(loc *time.Location) bool {
+	return IsLeapYear(t.In(loc).Year())
+}
+
+// DaysInMonth returns the number of days in the month containing the
+// timestamp, as observed in loc. A nil loc is treated as UTC.
+func (t Timestamp) DaysInMonth(loc *time.Location) int {
+	y, m, _ := t.In(loc).Date()
+	return DaysInMonth(y, m)
+}
+
+// StartOfMonth returns the first instant of the month containing the
+// timestamp, as observed in loc. A nil loc is treated as UTC.
+func (t Timestamp) StartOfMonth(loc *time.Location) Timestamp {
+	y, m, _ := t.In(loc).Date()
+	return StartOfDate(y, m, 1, loc)
+}
+
+// LastDayOfMonth returns midnight at the start of the last day of the
+// month containing the timestamp, as observed in loc, for example the
+// billing date of a cycle that ends with the month. If midnight does not
+// exist because of a daylight-saving transition, the first instant of the
+// day is returned. A nil loc is treated as UTC.
+func (t Timestamp) LastDayOfMonth(loc *time.Location) Timestamp {
+	y, m, _ := t.In(loc).Date()
+	return StartOfDate(y, m, DaysInMonth(y, m), loc)
+}
+
+// EndOfMonth returns the last nanosecond of the month containing the
+// timestamp, as observed in loc. A nil loc is treated as UTC.
+func (t Timestamp) EndOfMonth(loc *time.Location) Timestamp {
+	y, m, _ := t.In(loc).Date()
+	return StartOfDate(y, m+1, 1, loc) - 1
+}
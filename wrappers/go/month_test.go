@@ -0,0 +1,104 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDaysInMonth(t *testing.T) {
+	cases := []struct {
+		year  int
+		month time.Month
+		days  int
+	}{
+		{2024, time.January, 31},
+		{2024, time.February, 29},
+		{2023, time.February, 28},
+		{1900, time.February, 28},
+		{2000, time.February, 29},
+		{2024, time.April, 30},
+		{2024, time.December, 31},
+	}
+	for _, c := range cases {
+		if got := DaysInMonth(c.year, c.month); got != c.days {
+			t.Errorf("DaysInMonth(%d, %s) = %d, expected %d", c.year, c.month, got, c.days)
+		}
+	}
+
+	for year, leap := range map[int]bool{2024: true, 2023: false, 1900: false, 2000: true} {
+		if got := IsLeapYear(year); got != leap {
+			t.Errorf("IsLeapYear(%d) = %v, expected %v", year, got, leap)
+		}
+	}
+}
+
+func TestMonthMethods(t *testing.T) {
+	ts := mustParse(t, "2024-02-10T12:00:00Z")
+	if got := ts.DaysInMonth(nil); got != 29 {
+		t.Errorf("DaysInMonth() = %d, expected 29", got)
+	}
+	if !ts.IsLeapYear(nil) {
+		t.Errorf("IsLeapYear() = false, expected true")
+	}
+	if got := ts.StartOfMonth(nil).Format(); got != "2024-02-01T00:00:00Z" {
+		t.Errorf("StartOfMonth() = %s, expected 2024-02-01T00:00:00Z", got)
+	}
+	if got := ts.LastDayOfMonth(nil).Format(); got != "2024-02-29T00:00:00Z" {
+		t.Errorf("LastDayOfMonth() = %s, expected 2024-02-29T00:00:00Z", got)
+	}
+	if got := ts.EndOfMonth(nil).Format(); got != "2024-02-29T23:59:59.999999999Z" {
+		t.Errorf("EndOfMonth() = %s, expected 2024-02-29T23:59:59.999999999Z", got)
+	}
+}
+
+func TestMonthMethodsZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	// 2025-01-01T03:00Z is still 2024-12-31 in New York.
+	ts := mustParse(t, "2025-01-01T03:00:00Z")
+	if !ts.IsLeapYear(loc) {
+		t.Errorf("IsLeapYear(New_York) = false, expected true")
+	}
+	if ts.IsLeapYear(nil) {
+		t.Errorf("IsLeapYear(UTC) = true, expected false")
+	}
+	if got := ts.LastDayOfMonth(loc).Format(); got != "2024-12-31T05:00:00Z" {
+		t.Errorf("LastDayOfMonth(New_York) = %s, expected 2024-12-31T05:00:00Z", got)
+	}
+
+	// November 2024 ends on standard time after starting on daylight time.
+	nov := mustParse(t, "2024-11-15T12:00:00Z")
+	if got := nov.DaysInMonth(loc); got != 30 {
+		t.Errorf("DaysInMonth(New_York) = %d, expected 30", got)
+	}
+	if got := nov.StartOfMonth(loc).Format(); got != "2024-11-01T04:00:00Z" {
+		t.Errorf("StartOfMonth(New_York) = %s, expected 2024-11-01T04:00:00Z", got)
+	}
+	if got := nov.EndOfMonth(loc).Format(); got != "2024-12-01T04:59:59.999999999Z" {
+		t.Errorf("EndOfMonth(New_York) = %s, expected 2024-12-01T04:59:59.999999999Z", got)
+	}
+}
+
+func TestMonthMethodsMidnightGap(t *testing.T) {
+	havana, err := time.LoadLocation("America/Havana")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	// Havana skipped from 00:00 to 01:00 on 2012-04-01, so April began at
+	// 05:00 UTC and March ran until just before it.
+	apr := mustParse(t, "2012-04-15T12:00:00Z")
+	if got := apr.StartOfMonth(havana).Format(); got != "2012-04-01T05:00:00Z" {
+		t.Errorf("StartOfMonth(Havana) = %s, expected 2012-04-01T05:00:00Z", got)
+	}
+	mar := mustParse(t, "2012-03-15T12:00:00Z")
+	if got := mar.EndOfMonth(havana).Format(); got != "2012-04-01T04:59:59.999999999Z" {
+		t.Errorf("EndOfMonth(Havana) = %s, expected 2012-04-01T04:59:59.999999999Z", got)
+	}
+	if got := mar.LastDayOfMonth(havana).Format(); got != "2012-03-31T05:00:00Z" {
+		t.Errorf("LastDayOfMonth(Havana) = %s, expected 2012-03-31T05:00:00Z", got)
+	}
+}
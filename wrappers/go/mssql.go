@@ -0,0 +1,106 @@
+package universal_timestamp
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MSSQLTick is the resolution of SQL Server's datetime2(7) and
+// datetimeoffset(7) types.
+const MSSQLTick = 100 * Nanosecond
+
+// mssqlOffsetLayout is the text form SQL Server uses for datetimeoffset
+// values, as returned when they are cast to a string.
+const mssqlOffsetLayout = "2006-01-02 15:04:05.9999999 -07:00"
+
+// mssqlMaxOffset is the largest offset a datetimeoffset can hold, ±14:00.
+const mssqlMaxOffset = 14 * 60 * 60
+
+// RoundMSSQL rounds ts to scale fractional-second digits (0-7), the way
+// SQL Server rounds a value converted to datetime2(scale) or
+// datetimeoffset(scale): half a tick rounds up, carrying into the next
+// second or day if needed. go-mssqldb instead truncates to 100ns when
+// sending a time.Time, so values that must compare equal to ones SQL
+// Server rounded should pass through RoundMSSQL first. It returns
+// ErrInvalidPrecision for scales outside 0-7.
+func RoundMSSQL(ts Timestamp, scale int) (Timestamp, error) {
+	if scale < 0 || scale > 7 {
+		return 0, ErrInvalidPrecision
+	}
+	return roundDigits(ts, scale, RoundHalfUp), nil
+}
+
+// MSSQLDateTime2 is a Timestamp stored in a SQL Server datetime2 column.
+// Values are written as UTC and rounded to the nearest MSSQLTick, as SQL
+// Server itself rounds, rather than truncated by the driver.
+type MSSQLDateTime2 Timestamp
+
+// Value implements driver.Valuer. The value is a UTC time.Time rounded to
+// 100ns.
+func (t MSSQLDateTime2) Value() (driver.Value, error) {
+	ts, _ := RoundMSSQL(Timestamp(t), 7)
+	return ts.ToTime(), nil
+}
+
+// Scan implements sql.Scanner for time.Time values and for strings such
+// as "2024-12-14 12:00:00.1234567", which are read as UTC.
+func (t *MSSQLDateTime2) Scan(src interface{}) error {
+	if src == nil {
+		return errors.New("cannot scan NULL into MSSQLDateTime2")
+	}
+	ts, err := scanTimestamp(src)
+	if err != nil {
+		return err
+	}
+	*t = MSSQLDateTime2(ts)
+	return nil
+}
+
+// MSSQLDateTimeOffset is a ZonedTimestamp stored in a SQL Server
+// datetimeoffset column, which keeps the UTC offset but not the zone name.
+// Values scanned back therefore carry a fixed-offset location.
+type MSSQLDateTimeOffset ZonedTimestamp
+
+// Value implements driver.Valuer. The value is a time.Time rounded to
+// 100ns in a fixed zone with the offset in effect at the instant, which
+// go-mssqldb sends as that datetimeoffset. It returns ErrOutOfRange for
+// offsets SQL Server cannot store: those beyond ±14:00 or not a whole
+// number of minutes, such as local mean time in historical zones.
+func (t MSSQLDateTimeOffset) Value() (driver.Value, error) {
+	ts, _ := RoundMSSQL(t.Instant, 7)
+	name, offset := ts.In(t.Location).Zone()
+	if offset%60 != 0 || offset > mssqlMaxOffset || offset < -mssqlMaxOffset {
+		return nil, fmt.Errorf("%w: datetimeoffset cannot store the offset of %s in %s", ErrOutOfRange, name, t.Location)
+	}
+	return ts.In(time.FixedZone(name, offset)), nil
+}
+
+// Scan implements sql.Scanner for time.Time values, keeping their offset,
+// and for SQL Server's text form "2024-12-14 13:00:00.1234567 +01:00".
+func (t *MSSQLDateTimeOffset) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		return errors.New("cannot scan NULL into MSSQLDateTimeOffset")
+	case time.Time:
+		if _, err := scanTimestamp(v); err != nil {
+			return err
+		}
+		*t = MSSQLDateTimeOffset(ZonedFromTime(v))
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into MSSQLDateTimeOffset", src)
+	}
+
+	parsed, err := time.Parse(mssqlOffsetLayout, s)
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	return t.Scan(parsed)
+}
@@ -0,0 +1,97 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRoundMSSQL(t *testing.T) {
+	cases := []struct {
+		in       string
+		scale    int
+		expected string
+	}{
+		{"2024-12-14T12:00:00.12345675Z", 7, "2024-12-14T12:00:00.1234568Z"},
+		{"2024-12-14T12:00:00.12345674Z", 7, "2024-12-14T12:00:00.1234567Z"},
+		{"2024-12-31T23:59:59.99999995Z", 7, "2025-01-01T00:00:00Z"},
+		{"2024-12-14T12:00:00.1235Z", 3, "2024-12-14T12:00:00.124Z"},
+		{"1969-12-31T23:59:59.99999995Z", 7, "1970-01-01T00:00:00Z"},
+		{"2024-12-14T12:00:00.5Z", 0, "2024-12-14T12:00:01Z"},
+	}
+	for _, c := range cases {
+		got, err := RoundMSSQL(mustParse(t, c.in), c.scale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Format() != c.expected {
+			t.Errorf("RoundMSSQL(%s, %d) = %s, expected %s", c.in, c.scale, got.Format(), c.expected)
+		}
+	}
+
+	if _, err := RoundMSSQL(0, 8); !errors.Is(err, ErrInvalidPrecision) {
+		t.Errorf("RoundMSSQL(scale 8) error = %v, expected ErrInvalidPrecision", err)
+	}
+}
+
+func TestMSSQLDateTime2(t *testing.T) {
+	v, err := MSSQLDateTime2(mustParse(t, "2024-12-14T12:00:00.123456789Z")).Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := FromTime(v.(time.Time)).Format(); got != "2024-12-14T12:00:00.1234568Z" {
+		t.Errorf("Value() = %s, expected 2024-12-14T12:00:00.1234568Z", got)
+	}
+
+	var dt MSSQLDateTime2
+	if err := dt.Scan("2024-12-14 12:00:00.1234567"); err != nil {
+		t.Fatal(err)
+	}
+	if got := Timestamp(dt).Format(); got != "2024-12-14T12:00:00.1234567Z" {
+		t.Errorf("Scan() = %s, expected 2024-12-14T12:00:00.1234567Z", got)
+	}
+	if err := dt.Scan(nil); err == nil {
+		t.Errorf("Scan(nil) succeeded, expected an error")
+	}
+}
+
+func TestMSSQLDateTimeOffset(t *testing.T) {
+	loc := time.FixedZone("", 5*3600+30*60)
+	z := MSSQLDateTimeOffset(NewZoned(mustParse(t, "2024-12-14T12:00:00.00000005Z"), loc))
+	v, err := z.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tm := v.(time.Time)
+	if got := tm.Format(mssqlOffsetLayout); got != "2024-12-14 17:30:00.0000001 +05:30" {
+		t.Errorf("Value() = %s, expected 2024-12-14 17:30:00.0000001 +05:30", got)
+	}
+
+	var back MSSQLDateTimeOffset
+	if err := back.Scan(tm); err != nil {
+		t.Fatal(err)
+	}
+	if _, off := back.Instant.In(back.Location).Zone(); off != 5*3600+30*60 {
+		t.Errorf("Scan(time.Time) offset = %d, expected %d", off, 5*3600+30*60)
+	}
+
+	if err := back.Scan("2024-12-14 13:00:00.1234567 +01:00"); err != nil {
+		t.Fatal(err)
+	}
+	if got := back.Instant.Format(); got != "2024-12-14T12:00:00.1234567Z" {
+		t.Errorf("Scan(string) = %s, expected 2024-12-14T12:00:00.1234567Z", got)
+	}
+	if _, off := back.Instant.In(back.Location).Zone(); off != 3600 {
+		t.Errorf("Scan(string) offset = %d, expected 3600", off)
+	}
+	if err := back.Scan("14/12/2024"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Scan(invalid) error = %v, expected ErrInvalidFormat", err)
+	}
+}
+
+func TestMSSQLDateTimeOffsetUnsupported(t *testing.T) {
+	z := MSSQLDateTimeOffset(NewZoned(0, time.FixedZone("LMT", 517)))
+	if _, err := z.Value(); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Value(+00:08:37) error = %v, expected ErrOutOfRange", err)
+	}
+}
@@ -0,0 +1,13 @@
+//go:build go1.24
+
+// ut_format neither retains its buffer nor calls back into Go, which lets
+// AppendFormat keep its scratch buffer on the stack.
+
+package universal_timestamp
+
+/*
+#cgo noescape ut_format
+#cgo nocallback ut_format
+#include "universal_timestamp.h"
+*/
+import "C"
@@ -0,0 +1,47 @@
+package universal_timestamp
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+)
+
+// ObjectIDTimestamp returns the creation time embedded in a MongoDB
+// ObjectID: its first four bytes, a big-endian count of seconds since the
+// Unix epoch. The driver's bson.ObjectID and primitive.ObjectID types
+// convert to [12]byte directly.
+func ObjectIDTimestamp(id [12]byte) Timestamp {
+	return Timestamp(int64(binary.BigEndian.Uint32(id[:4])) * int64(Second))
+}
+
+// ParseObjectIDTimestamp returns the creation time embedded in the
+// 24-digit hexadecimal form of an ObjectID. It returns ErrInvalidFormat if
+// s is not a valid ObjectID.
+func ParseObjectIDTimestamp(s string) (Timestamp, error) {
+	var id [12]byte
+	if len(s) != 2*len(id) {
+		return 0, fmt.Errorf("%w: ObjectID %q must have 24 hex digits", ErrInvalidFormat, s)
+	}
+	if _, err := hex.Decode(id[:], []byte(s)); err != nil {
+		return 0, fmt.Errorf("%w: ObjectID %q", ErrInvalidFormat, s)
+	}
+	return ObjectIDTimestamp(id), nil
+}
+
+// ObjectIDFromTimestamp returns the smallest ObjectID created in the
+// second containing ts, with the remaining eight bytes zeroed, for use as
+// a range bound: {_id: {$gte: from, $lt: to}} selects the documents
+// created in [from, to) when both bounds are whole seconds. A fractional
+// second is floored, so a lower bound includes the whole second. It
+// returns ErrOutOfRange for instants before 1970 or after 2106, which the
+// four-byte field cannot hold.
+func ObjectIDFromTimestamp(ts Timestamp) ([12]byte, error) {
+	var id [12]byte
+	secs := floorDiv(int64(ts), int64(Second))
+	if secs < 0 || secs > math.MaxUint32 {
+		return id, fmt.Errorf("%w: %s does not fit in an ObjectID", ErrOutOfRange, ts.Format())
+	}
+	binary.BigEndian.PutUint32(id[:4], uint32(secs))
+	return id, nil
+}
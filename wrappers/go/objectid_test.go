@@ -0,0 +1,42 @@
+package universal_timestamp
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestObjectIDTimestamp(t *testing.T) {
+	ts, err := ParseObjectIDTimestamp("675d7340b8a2c1d4e5f60718")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ts.Format(); got != "2024-12-14T12:00:00Z" {
+		t.Errorf("ParseObjectIDTimestamp() = %s, expected 2024-12-14T12:00:00Z", got)
+	}
+
+	for _, s := range []string{"675d7340", "675d7340b8a2c1d4e5f6071g"} {
+		if _, err := ParseObjectIDTimestamp(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseObjectIDTimestamp(%q) error = %v, expected ErrInvalidFormat", s, err)
+		}
+	}
+}
+
+func TestObjectIDFromTimestamp(t *testing.T) {
+	id, err := ObjectIDFromTimestamp(mustParse(t, "2024-12-14T12:00:00.75Z"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hex.EncodeToString(id[:]); got != "675d73400000000000000000" {
+		t.Errorf("ObjectIDFromTimestamp() = %s, expected 675d73400000000000000000", got)
+	}
+	if got := ObjectIDTimestamp(id).Format(); got != "2024-12-14T12:00:00Z" {
+		t.Errorf("ObjectIDTimestamp() = %s, expected 2024-12-14T12:00:00Z", got)
+	}
+
+	for _, s := range []string{"1969-12-31T23:59:59.5Z", "2106-02-07T06:28:16Z"} {
+		if _, err := ObjectIDFromTimestamp(mustParse(t, s)); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("ObjectIDFromTimestamp(%s) error = %v, expected ErrOutOfRange", s, err)
+		}
+	}
+}
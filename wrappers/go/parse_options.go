@@ -0,0 +1,338 @@
+package universal_timestamp
+
+import "time"
+
+// parseConfig holds the settings applied by ParseOption values.
+type parseConfig struct {
+	allowNoOffset bool
+	zone          *time.Location
+	maxFraction   int
+	allowSpace    bool
+	twoDigitYears bool
+	pivot         int
+	slashDates    bool
+	dateOrder     DateOrder
+	abbreviations map[string]int
+	offsets       bool
+}
+
+// DateOrder declares how the components of a slash- or dot-separated date
+// are interpreted.
+type DateOrder int
+
+const (
+	// AutoDateOrder infers the order and rejects inputs that could be read
+	// more than one way with ErrAmbiguousDate. It is the zero value.
+	AutoDateOrder DateOrder = iota
+	// DMY reads dates as day, month, year (14/12/2024).
+	DMY
+	// MDY reads dates as month, day, year (12/14/2024).
+	MDY
+	// YMD reads dates as year, month, day (2024/12/14).
+	YMD
+)
+
+// ParseOption relaxes or tightens a single parsing rule.
+type ParseOption func(*parseConfig)
+
+// AllowNoOffset accepts inputs without a "Z" or offset suffix and treats
+// them as UTC.
+func AllowNoOffset() ParseOption {
+	return func(c *parseConfig) {
+		c.allowNoOffset = true
+	}
+}
+
+// AssumeZone accepts inputs without a "Z" or offset suffix and interprets
+// them as wall-clock time in loc. It implies AllowNoOffset.
+func AssumeZone(loc *time.Location) ParseOption {
+	return func(c *parseConfig) {
+		c.allowNoOffset = true
+		c.zone = loc
+	}
+}
+
+// MaxFractionDigits rejects inputs with more than n fractional-second
+// digits. Values of n above nine accept longer fractions, truncating them
+// to nanoseconds.
+func MaxFractionDigits(n int) ParseOption {
+	return func(c *parseConfig) {
+		c.maxFraction = n
+	}
+}
+
+// AllowSpaceSeparator accepts a space in place of the "T" separating the
+// date and time.
+func AllowSpaceSeparator() ParseOption {
+	return func(c *parseConfig) {
+		c.allowSpace = true
+	}
+}
+
+// TwoDigitYears accepts inputs whose year has only two digits, such as
+// "24-12-14T12:00:00Z", as found in legacy file formats. Two-digit years
+// below pivot are placed in the 2000s and the rest in the 1900s, so a
+// pivot of 69 maps 68 to 2068 and 69 to 1969.
+func TwoDigitYears(pivot int) ParseOption {
+	return func(c *parseConfig) {
+		c.twoDigitYears = true
+		c.pivot = pivot
+	}
+}
+
+// SlashDates accepts dates separated by '/' or '.' instead of '-', such as
+// "14/12/2024T12:00:00Z" or "14.12.2024", read in the given order. A date
+// without a time is taken as midnight UTC, or midnight in the AssumeZone
+// location. Years may have two digits when TwoDigitYears is also given.
+// With AutoDateOrder, a date that fits both day-month orders returns
+// ErrAmbiguousDate and one that fits neither returns ErrInvalidFormat.
+func SlashDates(order DateOrder) ParseOption {
+	return func(c *parseConfig) {
+		c.slashDates = true
+		c.dateOrder = order
+	}
+}
+
+// parseWithOptions normalizes s according to opts and parses the result
+// with the C core.
+func parseWithOptions(s string, strict bool, opts []ParseOption) (Timestamp, error) {
+	cfg := parseConfig{maxFraction: 9}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	ts, _, err := parseConfigured(s, strict, cfg)
+	return ts, err
+}
+
+// parseConfigured parses s according to cfg and returns the instant along
+// with the UTC offset, in seconds, that the input was written in.
+func parseConfigured(s string, strict bool, cfg parseConfig) (Timestamp, int, error) {
+	b := []byte(s)
+	if cfg.slashDates {
+		var err error
+		if b, err = normalizeSlashDate(b, cfg); err != nil {
+			return parseFailed(err)
+		}
+	}
+
+	if cfg.twoDigitYears && len(b) > 2 && b[2] == '-' && isDigit(b[0]) && isDigit(b[1]) {
+		b = append(expandTwoDigitYear(b[:2], cfg.pivot), b[2:]...)
+	}
+
+	if cfg.allowSpace && len(b) > 10 && b[10] == ' ' {
+		b[10] = 'T'
+	}
+
+	end := 19
+	if len(b) > end && b[end] == '.' {
+		start := end + 1
+		end = start
+		for end < len(b) && isDigit(b[end]) {
+			end++
+		}
+		digits := end - start
+		if digits > cfg.maxFraction {
+			return parseFailed(ErrFractionTooLong)
+		}
+		if digits > 9 {
+			b = append(b[:start+9], b[end:]...)
+			end = start + 9
+		}
+	}
+
+	offset := 0
+	if cfg.offsets && len(b) > end {
+		if off, ok := numericOffset(b[end:]); ok {
+			offset = off
+			b = append(b[:end], 'Z')
+		}
+	}
+	if cfg.abbreviations != nil && len(b) > end {
+		off, matched, err := zoneAbbreviationOffset(b[end:], cfg.abbreviations)
+		if err != nil {
+			return parseFailed(err)
+		}
+		if matched {
+			offset = off
+			b = append(b[:end], 'Z')
+		}
+	}
+
+	noOffset := len(b) == end && len(b) >= 19
+	if noOffset && cfg.allowNoOffset {
+		b = append(b, 'Z')
+	}
+
+	ts, err := parseC(string(b), strict)
+	if err != nil {
+		return 0, 0, err
+	}
+	if noOffset && cfg.zone != nil {
+		ts = reinterpretWallClock(ts, cfg.zone)
+		_, offset = ts.In(cfg.zone).Zone()
+		return ts, offset, nil
+	}
+	return ts - Timestamp(offset)*Timestamp(Second), offset, nil
+}
+
+// parseFailed reports err as a parse failure to the installed Metrics and
+// returns it.
+func parseFailed(err error) (Timestamp, int, error) {
+	observeParse(parseFailureReason(err))
+	return 0, 0, err
+}
+
+// reinterpretWallClock treats the UTC wall-clock reading of ts as local
+// time in loc and returns the corresponding instant.
+func reinterpretWallClock(ts Timestamp, loc *time.Location) Timestamp {
+	u := ts.ToTime()
+	return FromTime(time.Date(u.Year(), u.Month(), u.Day(),
+		u.Hour(), u.Minute(), u.Second(), u.Nanosecond(), loc))
+}
+
+// numericOffset decodes a "+HH:MM" or "-HH:MM" suffix into seconds east
+// of UTC.
+func numericOffset(rest []byte) (int, bool) {
+	if len(rest) != 6 || (rest[0] != '+' && rest[0] != '-') || rest[3] != ':' {
+		return 0, false
+	}
+	for _, i := range []int{1, 2, 4, 5} {
+		if !isDigit(rest[i]) {
+			return 0, false
+		}
+	}
+	hours := atoiDigits(rest[1:3])
+	minutes := atoiDigits(rest[4:6])
+	if hours > 23 || minutes > 59 {
+		return 0, false
+	}
+	offset := hours*3600 + minutes*60
+	if rest[0] == '-' {
+		offset = -offset
+	}
+	return offset, true
+}
+
+// isDigit reports whether c is an ASCII decimal digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// normalizeSlashDate rewrites a leading slash- or dot-separated date in b
+// as an ISO-8601 "YYYY-MM-DD" date. Inputs without such a date are
+// returned unchanged.
+func normalizeSlashDate(b []byte, cfg parseConfig) ([]byte, error) {
+	var parts [3][]byte
+	pos := 0
+	var sep byte
+	for i := 0; i < 3; i++ {
+		start := pos
+		for pos < len(b) && isDigit(b[pos]) {
+			pos++
+		}
+		if pos == start || pos-start > 4 {
+			return b, nil
+		}
+		parts[i] = b[start:pos]
+		if i == 2 {
+			break
+		}
+		if pos >= len(b) || (b[pos] != '/' && b[pos] != '.') || (sep != 0 && b[pos] != sep) {
+			return b, nil
+		}
+		sep = b[pos]
+		pos++
+	}
+
+	order := cfg.dateOrder
+	if order == AutoDateOrder {
+		var err error
+		if order, err = inferDateOrder(parts); err != nil {
+			return nil, err
+		}
+	}
+
+	var year, month, day []byte
+	switch order {
+	case YMD:
+		year, month, day = parts[0], parts[1], parts[2]
+	case MDY:
+		month, day, year = parts[0], parts[1], parts[2]
+	default:
+		day, month, year = parts[0], parts[1], parts[2]
+	}
+	if len(month) > 2 || len(day) > 2 {
+		return nil, ErrInvalidFormat
+	}
+
+	switch {
+	case len(year) == 4:
+	case len(year) == 2 && cfg.twoDigitYears:
+		year = expandTwoDigitYear(year, cfg.pivot)
+	default:
+		return nil, ErrInvalidFormat
+	}
+
+	out := make([]byte, 0, len(b)+10)
+	out = append(out, year...)
+	out = append(out, '-')
+	out = appendPadded2(out, month)
+	out = append(out, '-')
+	out = appendPadded2(out, day)
+	rest := b[pos:]
+	if len(rest) == 0 {
+		rest = []byte("T00:00:00Z")
+		if cfg.allowNoOffset {
+			rest = rest[:len(rest)-1]
+		}
+	}
+	return append(out, rest...), nil
+}
+
+// inferDateOrder picks the only plausible order for the components of a
+// slash-separated date. It returns ErrAmbiguousDate if both day-month
+// orders are plausible and ErrInvalidFormat if neither is.
+func inferDateOrder(parts [3][]byte) (DateOrder, error) {
+	if len(parts[0]) > 2 {
+		return YMD, nil
+	}
+
+	first, second := atoiDigits(parts[0]), atoiDigits(parts[1])
+	switch {
+	case first == second:
+		return DMY, nil
+	case first > 12 && second <= 12:
+		return DMY, nil
+	case second > 12 && first <= 12:
+		return MDY, nil
+	case first > 12 && second > 12:
+		return AutoDateOrder, ErrInvalidFormat
+	}
+	return AutoDateOrder, ErrAmbiguousDate
+}
+
+// expandTwoDigitYear returns the four-digit form of the two-digit year yy:
+// years below pivot fall in the 2000s, the rest in the 1900s.
+func expandTwoDigitYear(yy []byte, pivot int) []byte {
+	if atoiDigits(yy) < pivot {
+		return append([]byte("20"), yy...)
+	}
+	return append([]byte("19"), yy...)
+}
+
+// atoiDigits converts a short run of ASCII digits to an int.
+func atoiDigits(b []byte) int {
+	n := 0
+	for _, c := range b {
+		n = n*10 + int(c-'0')
+	}
+	return n
+}
+
+// appendPadded2 appends a one- or two-digit number, zero-padded to two digits.
+func appendPadded2(dst, digits []byte) []byte {
+	if len(digits) == 1 {
+		dst = append(dst, '0')
+	}
+	return append(dst, digits...)
+}
@@ -0,0 +1,193 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseOptions(t *testing.T) {
+	cases := []struct {
+		input    string
+		opts     []ParseOption
+		expected string
+	}{
+		{"2024-12-14T12:00:00", []ParseOption{AllowNoOffset()}, "2024-12-14T12:00:00Z"},
+		{"2024-12-14 12:00:00Z", []ParseOption{AllowSpaceSeparator()}, "2024-12-14T12:00:00Z"},
+		{"2024-12-14 12:00:00.25", []ParseOption{AllowSpaceSeparator(), AllowNoOffset()}, "2024-12-14T12:00:00.25Z"},
+		{"2024-12-14T12:00:00.123456789123Z", []ParseOption{MaxFractionDigits(12)}, "2024-12-14T12:00:00.123456789Z"},
+		{"2024-12-14T12:00:00.123Z", []ParseOption{MaxFractionDigits(3)}, "2024-12-14T12:00:00.123Z"},
+	}
+	for _, c := range cases {
+		ts, err := Parse(c.input, c.opts...)
+		if err != nil {
+			t.Errorf("Parse(%q) failed: %v", c.input, err)
+			continue
+		}
+		if got := ts.Format(); got != c.expected {
+			t.Errorf("Parse(%q) = %s, expected %s", c.input, got, c.expected)
+		}
+	}
+}
+
+func TestParseOptionsReject(t *testing.T) {
+	if _, err := Parse("2024-12-14T12:00:00.1234Z", MaxFractionDigits(3)); err != ErrFractionTooLong {
+		t.Errorf("expected ErrFractionTooLong, got %v", err)
+	}
+	if _, err := Parse("2024-12-14 12:00:00Z", AllowNoOffset()); err == nil {
+		t.Error("space separator accepted without AllowSpaceSeparator")
+	}
+	if _, err := Parse("2024-12-14T12:00:00", MaxFractionDigits(9)); err == nil {
+		t.Error("missing offset accepted without AllowNoOffset")
+	}
+}
+
+func TestAssumeZone(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	ts, err := Parse("2024-07-01T09:00:00", AssumeZone(loc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ts.Format(); got != "2024-07-01T07:00:00Z" {
+		t.Errorf("AssumeZone(Paris) = %s", got)
+	}
+
+	ts, err = Parse("2024-07-01T09:00:00Z", AssumeZone(loc))
+	if err != nil || ts.Format() != "2024-07-01T09:00:00Z" {
+		t.Errorf("AssumeZone changed an explicit Z input: %s, %v", ts.Format(), err)
+	}
+}
+
+func TestTwoDigitYears(t *testing.T) {
+	cases := []struct {
+		input    string
+		pivot    int
+		expected string
+	}{
+		{"68-12-14T12:00:00Z", 69, "2068-12-14T12:00:00Z"},
+		{"69-12-14T12:00:00Z", 69, "1969-12-14T12:00:00Z"},
+		{"69-12-14T12:00:00Z", 70, "2069-12-14T12:00:00Z"},
+		{"2024-12-14T12:00:00Z", 69, "2024-12-14T12:00:00Z"},
+	}
+	for _, c := range cases {
+		ts, err := Parse(c.input, TwoDigitYears(c.pivot))
+		if err != nil {
+			t.Errorf("Parse(%q, pivot %d) failed: %v", c.input, c.pivot, err)
+			continue
+		}
+		if got := ts.Format(); got != c.expected {
+			t.Errorf("Parse(%q, pivot %d) = %s, expected %s", c.input, c.pivot, got, c.expected)
+		}
+	}
+
+	if _, err := Parse("24-12-14T12:00:00Z"); err == nil {
+		t.Error("two-digit year accepted without TwoDigitYears")
+	}
+}
+
+func TestSlashDates(t *testing.T) {
+	cases := []struct {
+		input    string
+		order    DateOrder
+		expected string
+	}{
+		{"14/12/2024T12:00:00Z", AutoDateOrder, "2024-12-14T12:00:00Z"},
+		{"12/14/2024T12:00:00Z", AutoDateOrder, "2024-12-14T12:00:00Z"},
+		{"2024/12/14T12:00:00Z", AutoDateOrder, "2024-12-14T12:00:00Z"},
+		{"05.05.2024T12:00:00Z", AutoDateOrder, "2024-05-05T12:00:00Z"},
+		{"01/02/2024T12:00:00Z", DMY, "2024-02-01T12:00:00Z"},
+		{"01/02/2024T12:00:00Z", MDY, "2024-01-02T12:00:00Z"},
+		{"1.2.2024T00:00:00Z", DMY, "2024-02-01T00:00:00Z"},
+	}
+	for _, c := range cases {
+		ts, err := Parse(c.input, SlashDates(c.order))
+		if err != nil {
+			t.Errorf("Parse(%q) failed: %v", c.input, err)
+			continue
+		}
+		if got := ts.Format(); got != c.expected {
+			t.Errorf("Parse(%q) = %s, expected %s", c.input, got, c.expected)
+		}
+	}
+
+	ts, err := Parse("14/12/24", SlashDates(DMY), TwoDigitYears(69), AllowNoOffset())
+	if err != nil || ts.Format() != "2024-12-14T00:00:00Z" {
+		t.Errorf("date-only two-digit slash date = %s, %v", ts.Format(), err)
+	}
+
+	// A date on its own is midnight UTC without needing AllowNoOffset.
+	ts, err = Parse("14.12.2024", SlashDates(DMY))
+	if err != nil || ts.Format() != "2024-12-14T00:00:00Z" {
+		t.Errorf("date-only slash date = %s, %v", ts.Format(), err)
+	}
+	tokyo := time.FixedZone("", 9*3600)
+	ts, err = Parse("14.12.2024", SlashDates(DMY), AssumeZone(tokyo))
+	if err != nil || ts.Format() != "2024-12-13T15:00:00Z" {
+		t.Errorf("date-only slash date in AssumeZone = %s, %v", ts.Format(), err)
+	}
+}
+
+func TestSlashDatesAmbiguous(t *testing.T) {
+	if _, err := Parse("01/02/2024T12:00:00Z", SlashDates(AutoDateOrder)); err != ErrAmbiguousDate {
+		t.Errorf("expected ErrAmbiguousDate, got %v", err)
+	}
+	if _, err := Parse("13/14/2024T12:00:00Z", SlashDates(AutoDateOrder)); err != ErrInvalidFormat {
+		t.Errorf("13/14/2024: expected ErrInvalidFormat, got %v", err)
+	}
+	if _, err := Parse("14/12/2024T12:00:00Z", SlashDates(MDY)); err == nil {
+		t.Error("month 14 accepted with MDY")
+	}
+	if _, err := Parse("14/12/2024T12:00:00Z"); err == nil {
+		t.Error("slash date accepted without SlashDates")
+	}
+}
+
+func TestParseWithOffset(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+		offset   int
+	}{
+		{"2024-12-14T12:00:00Z", "2024-12-14T12:00:00Z", 0},
+		{"2024-12-14T12:00:00+05:30", "2024-12-14T06:30:00Z", 19800},
+		{"2024-12-14T12:00:00.25-08:00", "2024-12-14T20:00:00.25Z", -28800},
+		{"2024-12-14T12:00:00+00:00", "2024-12-14T12:00:00Z", 0},
+	}
+	for _, c := range cases {
+		ts, offset, err := ParseWithOffset(c.input)
+		if err != nil {
+			t.Errorf("ParseWithOffset(%q) failed: %v", c.input, err)
+			continue
+		}
+		if got := ts.Format(); got != c.expected || offset != c.offset {
+			t.Errorf("ParseWithOffset(%q) = %s, %d; expected %s, %d", c.input, got, offset, c.expected, c.offset)
+		}
+	}
+
+	_, offset, err := ParseWithOffset("2024-12-14T12:00:00 EST", AllowZoneAbbreviations(nil))
+	if err != nil || offset != -18000 {
+		t.Errorf("ParseWithOffset(EST) offset = %d, %v", offset, err)
+	}
+
+	if _, _, err := ParseWithOffset("2024-12-14T12:00:00+24:00"); err == nil {
+		t.Error("offset +24:00 accepted")
+	}
+	if _, err := Parse("2024-12-14T12:00:00+05:30"); err == nil {
+		t.Error("Parse accepted a non-zero offset")
+	}
+}
+
+func TestParseWithOffsetAssumeZone(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	ts, offset, err := ParseWithOffset("2024-07-01T09:00:00", AssumeZone(loc))
+	if err != nil || ts.Format() != "2024-07-01T07:00:00Z" || offset != 7200 {
+		t.Errorf("ParseWithOffset(AssumeZone) = %s, %d, %v", ts.Format(), offset, err)
+	}
+}
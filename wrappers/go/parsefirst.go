@@ -0,0 +1,38 @@
+package universal_timestamp
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ParseFirst parses s with each of layouts in turn, in the reference-time
+// form of time.Parse such as "02/Jan/2006:15:04:05 -0700", and returns the
+// instant from the first layout that matches along with its index in
+// layouts. This lets an ingestion config declare the formats each source
+// uses instead of relying on auto-detection. Layouts without a zone are
+// read as UTC. If no layout matches, it returns an index of -1 and
+// ErrInvalidFormat; an instant outside the representable range returns
+// ErrOutOfRange.
+func ParseFirst(layouts []string, s string) (Timestamp, int, error) {
+	return ParseFirstInLocation(layouts, s, nil)
+}
+
+// ParseFirstInLocation is like ParseFirst but reads layouts without a zone
+// in loc, as time.ParseInLocation does. A nil loc is treated as UTC.
+func ParseFirstInLocation(layouts []string, s string, loc *time.Location) (Timestamp, int, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	for i, layout := range layouts {
+		t, err := time.ParseInLocation(layout, s, loc)
+		if err != nil {
+			continue
+		}
+		if t.Before(time.Unix(0, math.MinInt64)) || t.After(time.Unix(0, math.MaxInt64)) {
+			return 0, i, fmt.Errorf("%w: %q", ErrOutOfRange, s)
+		}
+		return FromTime(t), i, nil
+	}
+	return 0, -1, fmt.Errorf("%w: %q matches none of %d layouts", ErrInvalidFormat, s, len(layouts))
+}
@@ -0,0 +1,52 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseFirst(t *testing.T) {
+	layouts := []string{
+		time.RFC3339Nano,
+		"02/Jan/2006:15:04:05 -0700",
+		"2006-01-02 15:04:05",
+	}
+	cases := []struct {
+		in       string
+		expected string
+		index    int
+	}{
+		{"2024-12-14T12:00:00.5Z", "2024-12-14T12:00:00.5Z", 0},
+		{"14/Dec/2024:13:00:00 +0100", "2024-12-14T12:00:00Z", 1},
+		{"2024-12-14 12:00:00", "2024-12-14T12:00:00Z", 2},
+	}
+	for _, c := range cases {
+		ts, i, err := ParseFirst(layouts, c.in)
+		if err != nil {
+			t.Errorf("ParseFirst(%q) error: %v", c.in, err)
+			continue
+		}
+		if got := ts.Format(); got != c.expected || i != c.index {
+			t.Errorf("ParseFirst(%q) = %s, %d; expected %s, %d", c.in, got, i, c.expected, c.index)
+		}
+	}
+
+	if _, i, err := ParseFirst(layouts, "Dec 14 12:00:00"); !errors.Is(err, ErrInvalidFormat) || i != -1 {
+		t.Errorf("ParseFirst() of an unmatched input = %d, %v; expected -1, ErrInvalidFormat", i, err)
+	}
+	if _, i, err := ParseFirst([]string{"2006-01-02"}, "9999-01-01"); !errors.Is(err, ErrOutOfRange) || i != 0 {
+		t.Errorf("ParseFirst() out of range = %d, %v; expected 0, ErrOutOfRange", i, err)
+	}
+}
+
+func TestParseFirstInLocation(t *testing.T) {
+	loc := time.FixedZone("", -5*3600)
+	ts, _, err := ParseFirstInLocation([]string{"2006-01-02 15:04:05"}, "2024-12-14 07:00:00", loc)
+	if err != nil {
+		t.Fatalf("ParseFirstInLocation() error: %v", err)
+	}
+	if got := ts.Format(); got != "2024-12-14T12:00:00Z" {
+		t.Errorf("ParseFirstInLocation() = %s, expected 2024-12-14T12:00:00Z", got)
+	}
+}
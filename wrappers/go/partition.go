@@ -0,0 +1,107 @@
+package universal_timestamp
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PartitionLayout selects the finest time component of a Hive-style
+// partition path.
+type PartitionLayout int
+
+const (
+	// PartitionByYear produces "year=2024".
+	PartitionByYear PartitionLayout = iota
+	// PartitionByMonth produces "year=2024/month=12".
+	PartitionByMonth
+	// PartitionByDay produces "year=2024/month=12/day=14".
+	PartitionByDay
+	// PartitionByHour produces "year=2024/month=12/day=14/hour=12".
+	PartitionByHour
+	// PartitionByMinute produces "year=2024/month=12/day=14/hour=12/minute=30".
+	PartitionByMinute
+)
+
+// partitionKeys are the Hive column names, in layout order.
+var partitionKeys = [...]string{"year", "month", "day", "hour", "minute"}
+
+// PartitionPath returns the Hive-style partition path of ts in UTC, such
+// as "year=2024/month=12/day=14/hour=12" for PartitionByHour. Components
+// other than the year are zero-padded to two digits so paths sort
+// chronologically.
+func PartitionPath(ts Timestamp, layout PartitionLayout) string {
+	if layout < PartitionByYear {
+		layout = PartitionByYear
+	}
+	if layout > PartitionByMinute {
+		layout = PartitionByMinute
+	}
+
+	t := ts.ToTime()
+	values := [...]int{t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute()}
+	b := make([]byte, 0, 48)
+	for i := 0; i <= int(layout); i++ {
+		if i > 0 {
+			b = append(b, '/')
+		}
+		b = append(b, partitionKeys[i]...)
+		b = append(b, '=')
+		if i == 0 {
+			b = strconv.AppendInt(b, int64(values[i]), 10)
+		} else {
+			b = appendPadded(b, values[i], 2)
+		}
+	}
+	return string(b)
+}
+
+// ParsePartitionPath finds the Hive-style time partition in path and
+// returns the start of the partition it names, together with its layout.
+// Other path segments, such as a bucket prefix or a file name, are
+// ignored, so "s3://lake/events/year=2024/month=12/day=14/part-0.parquet"
+// yields 2024-12-14T00:00:00Z and PartitionByDay. The components must
+// appear in order starting with year; it returns ErrInvalidFormat
+// otherwise and ErrOutOfRange for impossible dates.
+func ParsePartitionPath(path string) (Timestamp, PartitionLayout, error) {
+	segments := strings.Split(path, "/")
+	start := -1
+	for i, seg := range segments {
+		if strings.HasPrefix(seg, "year=") {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return 0, 0, ErrInvalidFormat
+	}
+
+	values := [...]int{0, 1, 1, 0, 0}
+	layout := PartitionByYear
+	for i, seg := range segments[start:] {
+		if i >= len(partitionKeys) {
+			break
+		}
+		value, ok := strings.CutPrefix(seg, partitionKeys[i]+"=")
+		if !ok {
+			break
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || value == "" || value[0] == '+' || value[0] == '-' {
+			return 0, 0, ErrInvalidFormat
+		}
+		values[i] = n
+		layout = PartitionLayout(i)
+	}
+
+	year, month, day, hour, minute := values[0], values[1], values[2], values[3], values[4]
+	if month < 1 || month > 12 || day < 1 || day > DaysInMonth(year, time.Month(month)) || hour > 23 || minute > 59 {
+		return 0, 0, ErrOutOfRange
+	}
+	t := time.Date(year, time.Month(month), day, hour, minute, 0, 0, time.UTC)
+	if t.Before(time.Unix(0, math.MinInt64)) || t.After(time.Unix(0, math.MaxInt64)) {
+		return 0, 0, ErrOutOfRange
+	}
+	return FromTime(t), layout, nil
+}
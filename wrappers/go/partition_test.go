@@ -0,0 +1,69 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPartitionPath(t *testing.T) {
+	ts := mustParse(t, "2024-03-04T05:06:07Z")
+	tests := []struct {
+		layout   PartitionLayout
+		expected string
+	}{
+		{PartitionByYear, "year=2024"},
+		{PartitionByMonth, "year=2024/month=03"},
+		{PartitionByDay, "year=2024/month=03/day=04"},
+		{PartitionByHour, "year=2024/month=03/day=04/hour=05"},
+		{PartitionByMinute, "year=2024/month=03/day=04/hour=05/minute=06"},
+	}
+	for _, tt := range tests {
+		if got := PartitionPath(ts, tt.layout); got != tt.expected {
+			t.Errorf("PartitionPath(%d) = %s, expected %s", tt.layout, got, tt.expected)
+		}
+	}
+}
+
+func TestParsePartitionPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+		layout   PartitionLayout
+	}{
+		{"year=2024/month=12/day=14/hour=12", "2024-12-14T12:00:00Z", PartitionByHour},
+		{"s3://lake/events/year=2024/month=12/day=14/part-0.parquet", "2024-12-14T00:00:00Z", PartitionByDay},
+		{"year=2024", "2024-01-01T00:00:00Z", PartitionByYear},
+		{"/data/year=1969/month=7/", "1969-07-01T00:00:00Z", PartitionByMonth},
+	}
+	for _, tt := range tests {
+		ts, layout, err := ParsePartitionPath(tt.path)
+		if err != nil {
+			t.Errorf("ParsePartitionPath(%q) error: %v", tt.path, err)
+			continue
+		}
+		if ts.Format() != tt.expected || layout != tt.layout {
+			t.Errorf("ParsePartitionPath(%q) = %s, %d, expected %s, %d", tt.path, ts.Format(), layout, tt.expected, tt.layout)
+		}
+	}
+
+	ts := mustParse(t, "2024-12-14T12:34:00Z")
+	if got, _, _ := ParsePartitionPath(PartitionPath(ts, PartitionByMinute)); got != ts {
+		t.Errorf("round trip = %s, expected %s", got.Format(), ts.Format())
+	}
+}
+
+func TestParsePartitionPathInvalid(t *testing.T) {
+	invalid := map[string]error{
+		"events/month=12/day=14":    ErrInvalidFormat,
+		"year=abc":                  ErrInvalidFormat,
+		"year=2024/month=-1":        ErrInvalidFormat,
+		"year=2024/month=13":        ErrOutOfRange,
+		"year=2023/month=02/day=29": ErrOutOfRange,
+		"year=3000":                 ErrOutOfRange,
+	}
+	for path, want := range invalid {
+		if _, _, err := ParsePartitionPath(path); !errors.Is(err, want) {
+			t.Errorf("ParsePartitionPath(%q) error = %v, expected %v", path, err, want)
+		}
+	}
+}
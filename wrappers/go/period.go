@@ -0,0 +1,137 @@
+package universal_timestamp
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period is a calendar quantity of years, months and days. Unlike
+// Duration, its length in nanoseconds depends on where it is applied:
+// one month may be 28 to 31 days and one day may be 23 to 25 hours.
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// ParsePeriod parses an ISO-8601 date-based period such as "P1Y2M3D",
+// "P6M" or "P2W". A leading '-' negates the whole period and individual
+// components may also be negative ("P1Y-2M").
+func ParsePeriod(s string) (Period, error) {
+	neg := false
+	if strings.HasPrefix(s, "-") {
+		neg = true
+		s = s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	if len(s) < 3 || (s[0] != 'P' && s[0] != 'p') {
+		return Period{}, ErrInvalidFormat
+	}
+
+	var p Period
+	rest := s[1:]
+	last := -1
+	for rest != "" {
+		i := 0
+		if rest[0] == '-' || rest[0] == '+' {
+			i++
+		}
+		for i < len(rest) && isDigit(rest[i]) {
+			i++
+		}
+		if i == len(rest) {
+			return Period{}, ErrInvalidFormat
+		}
+		n, err := strconv.Atoi(rest[:i])
+		if err != nil {
+			return Period{}, ErrInvalidFormat
+		}
+
+		order := strings.IndexByte("YMWD", rest[i]&^0x20)
+		if order <= last {
+			return Period{}, ErrInvalidFormat
+		}
+		last = order
+		switch order {
+		case 0:
+			p.Years = n
+		case 1:
+			p.Months = n
+		case 2:
+			p.Days += 7 * n
+		case 3:
+			p.Days += n
+		}
+		rest = rest[i+1:]
+	}
+
+	if neg {
+		p = p.Negate()
+	}
+	return p, nil
+}
+
+// String formats the period in ISO-8601 notation, such as "P1Y2M3D".
+// A zero period is "P0D".
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+	var b strings.Builder
+	b.WriteByte('P')
+	for _, c := range []struct {
+		n      int
+		suffix byte
+	}{{p.Years, 'Y'}, {p.Months, 'M'}, {p.Days, 'D'}} {
+		if c.n != 0 {
+			b.WriteString(strconv.Itoa(c.n))
+			b.WriteByte(c.suffix)
+		}
+	}
+	return b.String()
+}
+
+// IsZero reports whether every component is zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negate returns the period with every component negated.
+func (p Period) Negate() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days}
+}
+
+// Normalize folds whole years out of Months so that Months lies in
+// (-12, 12) with the same sign as the combined year-month total. Days are
+// left alone because their relation to months varies.
+func (p Period) Normalize() Period {
+	total := p.Years*12 + p.Months
+	return Period{Years: total / 12, Months: total % 12, Days: p.Days}
+}
+
+// AddPeriod adds p to the wall-clock date of t in loc, keeping the time of
+// day. Years and months are applied first; if the resulting month is too
+// short the day is clamped to its last day, so January 31 plus one month
+// is the end of February. Days are applied afterwards. A nil loc is
+// treated as UTC.
+func (t Timestamp) AddPeriod(p Period, loc *time.Location) Timestamp {
+	lt := t.In(loc)
+	year, month, day := lt.Date()
+
+	months := year*12 + int(month) - 1 + p.Years*12 + p.Months
+	year, month = floorDivInt(months, 12), time.Month(months-floorDivInt(months, 12)*12+1)
+	if last := DaysInMonth(year, month); day > last {
+		day = last
+	}
+
+	hour, minute, second := lt.Clock()
+	result := time.Date(year, month, day+p.Days, hour, minute, second, lt.Nanosecond(), lt.Location())
+	return FromTime(result)
+}
+
+// floorDivInt divides a by b, rounding towards negative infinity.
+func floorDivInt(a, b int) int {
+	return int(floorDiv(int64(a), int64(b)))
+}
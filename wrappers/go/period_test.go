@@ -0,0 +1,79 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	cases := map[string]Period{
+		"P1Y2M3D": {1, 2, 3},
+		"P6M":     {0, 6, 0},
+		"P2W":     {0, 0, 14},
+		"P1W2D":   {0, 0, 9},
+		"-P1M":    {0, -1, 0},
+		"P1Y-2M":  {1, -2, 0},
+		"P0D":     {},
+	}
+	for input, expected := range cases {
+		got, err := ParsePeriod(input)
+		if err != nil || got != expected {
+			t.Errorf("ParsePeriod(%q) = %+v, %v; expected %+v", input, got, err, expected)
+		}
+	}
+
+	for _, input := range []string{"", "P", "1Y", "P1D1Y", "PT1H", "P1", "P1X"} {
+		if _, err := ParsePeriod(input); err == nil {
+			t.Errorf("ParsePeriod(%q) succeeded", input)
+		}
+	}
+}
+
+func TestPeriodString(t *testing.T) {
+	if got := (Period{1, 2, 3}).String(); got != "P1Y2M3D" {
+		t.Errorf("String() = %s", got)
+	}
+	if got := (Period{}).String(); got != "P0D" {
+		t.Errorf("zero String() = %s", got)
+	}
+	if got := (Period{Months: 27, Days: 40}).Normalize(); got != (Period{2, 3, 40}) {
+		t.Errorf("Normalize() = %+v", got)
+	}
+	if got := (Period{Years: 1, Months: -14}).Normalize(); got != (Period{0, -2, 0}) {
+		t.Errorf("Normalize() negative = %+v", got)
+	}
+}
+
+func TestAddPeriod(t *testing.T) {
+	cases := []struct {
+		start    string
+		period   Period
+		expected string
+	}{
+		{"2024-01-31T10:00:00Z", Period{Months: 1}, "2024-02-29T10:00:00Z"},
+		{"2023-01-31T10:00:00Z", Period{Months: 1}, "2023-02-28T10:00:00Z"},
+		{"2024-02-29T00:00:00Z", Period{Years: 1}, "2025-02-28T00:00:00Z"},
+		{"2024-12-14T00:00:00Z", Period{Months: 3}, "2025-03-14T00:00:00Z"},
+		{"2024-03-14T00:00:00Z", Period{Months: -3}, "2023-12-14T00:00:00Z"},
+		{"2024-12-14T00:00:00Z", Period{Days: 20}, "2025-01-03T00:00:00Z"},
+	}
+	for _, c := range cases {
+		got := mustParse(t, c.start).AddPeriod(c.period, nil).Format()
+		if got != c.expected {
+			t.Errorf("%s + %s = %s, expected %s", c.start, c.period, got, c.expected)
+		}
+	}
+}
+
+func TestAddPeriodZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	start := mustParse(t, "2024-03-09T14:00:00Z")
+	got := start.AddPeriod(Period{Days: 1}, loc)
+	if Duration(got-start) != 23*Hour {
+		t.Errorf("P1D across DST = %s, expected 23h", Duration(got-start))
+	}
+}
@@ -0,0 +1,198 @@
+package universal_timestamp
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// LoadPOSIXTZ returns a Location described by a POSIX TZ string such as
+// "EST5EDT,M3.2.0,M11.1.0" or "<+0330>-3:30", for targets without a tzdata
+// database. Note that POSIX offsets are west of UTC, so "EST5" is UTC-5. A
+// zone with a daylight-saving name but no rules uses the current US rules.
+// The rules apply to every year, past and future.
+// It returns ErrInvalidFormat if tz is not a valid TZ string.
+func LoadPOSIXTZ(tz string) (*time.Location, error) {
+	name, offset, ok := parsePOSIXTZ(tz)
+	if !ok {
+		return nil, ErrInvalidFormat
+	}
+	return time.LoadLocationFromTZData(tz, posixTZif(tz, name, offset))
+}
+
+// posixTZif builds a TZif version 2 file with no transitions, a single
+// standard-time type and tz as its footer, which the time package applies
+// to all instants.
+func posixTZif(tz, name string, offset int) []byte {
+	block := func(b []byte) []byte {
+		b = append(b, "TZif2"...)
+		b = append(b, make([]byte, 15)...)
+		// isutcnt, isstdcnt, leapcnt, timecnt, typecnt, charcnt.
+		for _, n := range []int{0, 0, 0, 0, 1, len(name) + 1} {
+			b = binary.BigEndian.AppendUint32(b, uint32(n))
+		}
+		b = binary.BigEndian.AppendUint32(b, uint32(int32(offset)))
+		b = append(b, 0, 0)
+		b = append(b, name...)
+		return append(b, 0)
+	}
+
+	var b []byte
+	b = block(b)
+	b = block(b)
+	b = append(b, '\n')
+	b = append(b, tz...)
+	return append(b, '\n')
+}
+
+// parsePOSIXTZ validates tz and returns the standard-time abbreviation and
+// its offset in seconds east of UTC.
+func parsePOSIXTZ(tz string) (name string, offset int, ok bool) {
+	p := posixTZParser{s: tz}
+	if name, ok = p.name(); !ok {
+		return "", 0, false
+	}
+	off, ok := p.offset(24)
+	if !ok {
+		return "", 0, false
+	}
+	if p.done() {
+		return name, -off, true
+	}
+
+	if _, ok := p.name(); !ok {
+		return "", 0, false
+	}
+	if !p.done() && p.peek() != ',' {
+		if _, ok := p.offset(24); !ok {
+			return "", 0, false
+		}
+	}
+	if p.done() {
+		return name, -off, true
+	}
+	for i := 0; i < 2; i++ {
+		if !p.consume(',') || !p.rule() {
+			return "", 0, false
+		}
+	}
+	return name, -off, p.done()
+}
+
+// posixTZParser scans a POSIX TZ string.
+type posixTZParser struct {
+	s string
+	i int
+}
+
+func (p *posixTZParser) done() bool { return p.i == len(p.s) }
+
+func (p *posixTZParser) peek() byte { return p.s[p.i] }
+
+func (p *posixTZParser) consume(c byte) bool {
+	if !p.done() && p.s[p.i] == c {
+		p.i++
+		return true
+	}
+	return false
+}
+
+// name scans an abbreviation: three or more letters, or any run of
+// letters, digits and signs in angle brackets.
+func (p *posixTZParser) name() (string, bool) {
+	start := p.i
+	if p.consume('<') {
+		for !p.done() && p.peek() != '>' {
+			c := p.peek()
+			if !isDigit(c) && !isASCIILetter(c) && c != '+' && c != '-' {
+				return "", false
+			}
+			p.i++
+		}
+		n := p.s[start+1 : p.i]
+		if !p.consume('>') || len(n) < 3 {
+			return "", false
+		}
+		return n, true
+	}
+	for !p.done() && isASCIILetter(p.peek()) {
+		p.i++
+	}
+	if p.i-start < 3 {
+		return "", false
+	}
+	return p.s[start:p.i], true
+}
+
+// offset scans [+-]hh[:mm[:ss]] with hh at most maxHours and returns it in
+// seconds.
+func (p *posixTZParser) offset(maxHours int) (int, bool) {
+	sign := 1
+	if p.consume('-') {
+		sign = -1
+	} else {
+		p.consume('+')
+	}
+	h, ok := p.number(1, 3)
+	if !ok || h > maxHours {
+		return 0, false
+	}
+	secs := h * 3600
+	for _, unit := range []int{60, 1} {
+		if !p.consume(':') {
+			break
+		}
+		n, ok := p.number(2, 2)
+		if !ok || n > 59 {
+			return 0, false
+		}
+		secs += n * unit
+	}
+	return sign * secs, true
+}
+
+// rule scans a transition date, Jn, n or Mm.w.d, and optional /time.
+func (p *posixTZParser) rule() bool {
+	switch {
+	case p.consume('J'):
+		if n, ok := p.number(1, 3); !ok || n < 1 || n > 365 {
+			return false
+		}
+	case p.consume('M'):
+		m, ok := p.number(1, 2)
+		if !ok || m < 1 || m > 12 || !p.consume('.') {
+			return false
+		}
+		w, ok := p.number(1, 1)
+		if !ok || w < 1 || w > 5 || !p.consume('.') {
+			return false
+		}
+		if d, ok := p.number(1, 1); !ok || d > 6 {
+			return false
+		}
+	default:
+		if n, ok := p.number(1, 3); !ok || n > 365 {
+			return false
+		}
+	}
+	if p.consume('/') {
+		if _, ok := p.offset(167); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// number scans between minDigits and maxDigits decimal digits.
+func (p *posixTZParser) number(minDigits, maxDigits int) (int, bool) {
+	n, digits := 0, 0
+	for !p.done() && isDigit(p.peek()) && digits < maxDigits {
+		n = n*10 + int(p.peek()-'0')
+		p.i++
+		digits++
+	}
+	return n, digits >= minDigits
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,68 @@
+package universal_timestamp
+
+import "testing"
+
+func TestLoadPOSIXTZ(t *testing.T) {
+	loc, err := LoadPOSIXTZ("EST5EDT,M3.2.0,M11.1.0")
+	if err != nil {
+		t.Fatalf("LoadPOSIXTZ() failed: %v", err)
+	}
+
+	cases := map[string]string{
+		"2024-01-15T12:00:00Z": "2024-01-15 07:00 -0500 EST",
+		"2024-07-15T12:00:00Z": "2024-07-15 08:00 -0400 EDT",
+		// Spring forward at 02:00 local on the second Sunday in March.
+		"2024-03-10T06:59:59Z": "2024-03-10 01:59 -0500 EST",
+		"2024-03-10T07:00:00Z": "2024-03-10 03:00 -0400 EDT",
+		"1995-07-01T00:00:00Z": "1995-06-30 20:00 -0400 EDT",
+	}
+	for input, expected := range cases {
+		if got := mustParse(t, input).Strftime("%Y-%m-%d %H:%M %z %Z", loc); got != expected {
+			t.Errorf("In(%s) = %s, expected %s", input, got, expected)
+		}
+	}
+}
+
+func TestLoadPOSIXTZForms(t *testing.T) {
+	cases := map[string]string{
+		"UTC0":                             "+0000 UTC",
+		"<+0330>-3:30":                     "+0330 +0330",
+		"AEST-10AEDT,M10.1.0,M4.1.0/3":     "+1100 AEDT",
+		"CET-1CEST,M3.5.0,M10.5.0/3":       "+0100 CET",
+		"NZST-12NZDT-13,M9.5.0,M4.1.0/3":   "+1300 NZDT",
+		"<-03>3<-02>,M3.5.0/-2,M10.5.0/-1": "-0300 -03",
+		"WART4WARST,J1/0,J365/25":          "-0300 WARST",
+	}
+	ts := mustParse(t, "2024-01-15T12:00:00Z")
+	for tz, expected := range cases {
+		loc, err := LoadPOSIXTZ(tz)
+		if err != nil {
+			t.Errorf("LoadPOSIXTZ(%q) failed: %v", tz, err)
+			continue
+		}
+		if got := ts.Strftime("%z %Z", loc); got != expected {
+			t.Errorf("LoadPOSIXTZ(%q) in January = %s, expected %s", tz, got, expected)
+		}
+	}
+}
+
+func TestLoadPOSIXTZErrors(t *testing.T) {
+	for _, tz := range []string{
+		"",
+		"ES5",
+		"EST",
+		"EST25",
+		"EST5EDT,M3.2.0",
+		"EST5EDT,M13.2.0,M11.1.0",
+		"EST5EDT,M3.6.0,M11.1.0",
+		"EST5EDT,M3.2.7,M11.1.0",
+		"EST5EDT,J0,J365",
+		"<+03",
+		"EST5:60",
+		"EST5EDT,M3.2.0,M11.1.0x",
+	} {
+		if _, err := LoadPOSIXTZ(tz); err != ErrInvalidFormat {
+			t.Errorf("LoadPOSIXTZ(%q) = %v, expected ErrInvalidFormat", tz, err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package universal_timestamp
+
+import "math"
+
+// ToPrometheusMillis converts ts to the int64 Unix milliseconds used by
+// Prometheus samples. Sub-millisecond precision is floored, matching
+// Prometheus' own timestamp.FromTime.
+func ToPrometheusMillis(ts Timestamp) int64 {
+	return floorDiv(int64(ts), int64(Millisecond))
+}
+
+// FromPrometheusMillis converts Prometheus Unix milliseconds to a Timestamp.
+// Values outside the Timestamp range, such as the math.MinInt64 and
+// math.MaxInt64 sentinels used for open-ended queries, saturate.
+func FromPrometheusMillis(ms int64) Timestamp {
+	const limit = math.MaxInt64 / int64(Millisecond)
+	switch {
+	case ms > limit:
+		return math.MaxInt64
+	case ms < -limit:
+		return math.MinInt64
+	}
+	return Timestamp(ms * int64(Millisecond))
+}
+
+// AlignToScrapeInterval floors ts to the most recent multiple of interval
+// since the Unix epoch, so samples from several exporters line up on the
+// same scrape boundaries. A non-positive interval returns ts unchanged.
+func AlignToScrapeInterval(ts Timestamp, interval Duration) Timestamp {
+	if interval <= 0 {
+		return ts
+	}
+	return Timestamp(floorMultiple(int64(ts), int64(interval)))
+}
@@ -0,0 +1,39 @@
+package universal_timestamp
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPrometheusMillis(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00.123456789Z")
+	if ms := ToPrometheusMillis(ts); ms != 1734177600123 {
+		t.Errorf("ToPrometheusMillis() = %d", ms)
+	}
+	if ms := ToPrometheusMillis(-1); ms != -1 {
+		t.Errorf("ToPrometheusMillis(-1) = %d, expected -1", ms)
+	}
+
+	if got := FromPrometheusMillis(1734177600123).Format(); got != "2024-12-14T12:00:00.123Z" {
+		t.Errorf("FromPrometheusMillis() = %s", got)
+	}
+	if got := FromPrometheusMillis(math.MaxInt64); got != math.MaxInt64 {
+		t.Errorf("FromPrometheusMillis(MaxInt64) = %d, expected saturation", got)
+	}
+	if got := FromPrometheusMillis(math.MinInt64); got != math.MinInt64 {
+		t.Errorf("FromPrometheusMillis(MinInt64) = %d, expected saturation", got)
+	}
+}
+
+func TestAlignToScrapeInterval(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:47.5Z")
+	if got := AlignToScrapeInterval(ts, 15*Second).Format(); got != "2024-12-14T12:00:45Z" {
+		t.Errorf("AlignToScrapeInterval() = %s", got)
+	}
+	if got := AlignToScrapeInterval(ts, 0); got != ts {
+		t.Errorf("AlignToScrapeInterval(0) changed the timestamp")
+	}
+	if got := AlignToScrapeInterval(math.MinInt64, 15*Second); got >= math.MinInt64+Timestamp(15*Second) || got%Timestamp(15*Second) != 0 {
+		t.Errorf("AlignToScrapeInterval(MinInt64) = %d, expected the earliest boundary in range", got)
+	}
+}
@@ -0,0 +1,23 @@
+package universal_timestamp
+
+import "time"
+
+// Quarter returns the calendar quarter (1-4) containing the timestamp in loc.
+// A nil loc is treated as UTC.
+func (t Timestamp) Quarter(loc *time.Location) int {
+	return (int(t.In(loc).Month())-1)/3 + 1
+}
+
+// StartOfQuarter returns the first instant of the quarter containing the
+// timestamp, as observed in loc. A nil loc is treated as UTC.
+func (t Timestamp) StartOfQuarter(loc *time.Location) Timestamp {
+	month := time.Month((t.Quarter(loc)-1)*3 + 1)
+	return StartOfDate(t.In(loc).Year(), month, 1, loc)
+}
+
+// EndOfQuarter returns the last nanosecond of the quarter containing the
+// timestamp, as observed in loc. A nil loc is treated as UTC.
+func (t Timestamp) EndOfQuarter(loc *time.Location) Timestamp {
+	month := time.Month((t.Quarter(loc)-1)*3 + 1)
+	return StartOfDate(t.In(loc).Year(), month+3, 1, loc) - 1
+}
@@ -0,0 +1,63 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuarter(t *testing.T) {
+	ts, _ := Parse("2024-08-15T12:00:00Z")
+	if q := ts.Quarter(nil); q != 3 {
+		t.Errorf("Quarter() = %d, expected 3", q)
+	}
+
+	start := ts.StartOfQuarter(nil).Format()
+	if start != "2024-07-01T00:00:00Z" {
+		t.Errorf("StartOfQuarter() = %s", start)
+	}
+
+	end := ts.EndOfQuarter(nil).Format()
+	if end != "2024-09-30T23:59:59.999999999Z" {
+		t.Errorf("EndOfQuarter() = %s", end)
+	}
+}
+
+func TestQuarterZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	ts, _ := Parse("2025-01-01T03:00:00Z")
+	if q := ts.Quarter(loc); q != 4 {
+		t.Errorf("Quarter(New_York) = %d, expected 4", q)
+	}
+	if q := ts.Quarter(nil); q != 1 {
+		t.Errorf("Quarter(UTC) = %d, expected 1", q)
+	}
+
+	start := ts.StartOfQuarter(loc).Format()
+	if start != "2024-10-01T04:00:00Z" {
+		t.Errorf("StartOfQuarter(New_York) = %s", start)
+	}
+
+	end := ts.EndOfQuarter(loc).Format()
+	if end != "2025-01-01T04:59:59.999999999Z" {
+		t.Errorf("EndOfQuarter(New_York) = %s", end)
+	}
+}
+
+func TestQuarterMidnightGap(t *testing.T) {
+	havana, err := time.LoadLocation("America/Havana")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	// Havana skipped from 00:00 to 01:00 on 2012-04-01, the first day of Q2.
+	if got := mustParse(t, "2012-05-15T12:00:00Z").StartOfQuarter(havana).Format(); got != "2012-04-01T05:00:00Z" {
+		t.Errorf("StartOfQuarter(Havana) = %s, expected 2012-04-01T05:00:00Z", got)
+	}
+	if got := mustParse(t, "2012-02-15T12:00:00Z").EndOfQuarter(havana).Format(); got != "2012-04-01T04:59:59.999999999Z" {
+		t.Errorf("EndOfQuarter(Havana) = %s, expected 2012-04-01T04:59:59.999999999Z", got)
+	}
+}
@@ -0,0 +1,106 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"sync"
+)
+
+// RateLimiter is a token bucket that refills one token every interval up
+// to a capacity of burst, driven by a Clock so tests can advance time
+// manually. It is implemented as the equivalent generic cell rate
+// algorithm, tracking only the instant at which the bucket will next be
+// full. It is safe for concurrent use.
+type RateLimiter struct {
+	mu       sync.Mutex
+	clock    Clock
+	interval Duration
+	burst    int
+	full     Timestamp
+}
+
+// NewRateLimiter returns a limiter allowing on average one event per
+// interval, with bursts of up to burst events. The bucket starts full. A
+// nil clock uses DefaultClock.
+func NewRateLimiter(clock Clock, interval Duration, burst int) (*RateLimiter, error) {
+	if interval <= 0 || burst <= 0 {
+		return nil, errors.New("rate limiter interval and burst must be positive")
+	}
+	return &RateLimiter{clock: clockOrSystem(clock), interval: interval, burst: burst}, nil
+}
+
+// Allow reports whether an event may happen now, consuming a token if so.
+func (l *RateLimiter) Allow() bool {
+	return l.AllowN(1)
+}
+
+// AllowN reports whether n events may happen now, consuming n tokens if so
+// and none otherwise. A non-positive n is never allowed.
+func (l *RateLimiter) AllowN(n int) bool {
+	if n <= 0 {
+		return false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	full, ready := l.take(now, n)
+	if n > l.burst || ready > now {
+		return false
+	}
+	l.full = full
+	return true
+}
+
+// Reserve consumes a token and returns the instant at which the caller may
+// act, which is the current time if a token was available. Callers wait
+// until the returned Timestamp, for example with SleepUntilClock on the
+// limiter's clock.
+func (l *RateLimiter) Reserve() Timestamp {
+	ts, _ := l.ReserveN(1)
+	return ts
+}
+
+// ReserveN consumes n tokens and returns the instant at which all of them
+// are available. It returns an error without reserving if n is not positive
+// or exceeds the burst, since such a request could never be satisfied.
+func (l *RateLimiter) ReserveN(n int) (Timestamp, error) {
+	if n <= 0 {
+		return 0, errors.New("rate limiter reservation must be positive")
+	}
+	if n > l.burst {
+		return 0, errors.New("rate limiter reservation exceeds burst")
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	full, ready := l.take(now, n)
+	l.full = full
+	if ready < now {
+		ready = now
+	}
+	return ready, nil
+}
+
+// Tokens returns the number of tokens currently available.
+func (l *RateLimiter) Tokens() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	missing := l.clock.Until(l.full)
+	if missing <= 0 {
+		return l.burst
+	}
+	return l.burst - int((missing+l.interval-1)/l.interval)
+}
+
+// take computes the new full instant after consuming n tokens at now, and
+// the instant at which those tokens become available.
+func (l *RateLimiter) take(now Timestamp, n int) (full, ready Timestamp) {
+	full = l.full
+	if full < now {
+		full = now
+	}
+	full += Timestamp(n) * Timestamp(l.interval)
+	return full, full - Timestamp(l.burst)*Timestamp(l.interval)
+}
@@ -0,0 +1,88 @@
+package universal_timestamp
+
+import "testing"
+
+func TestRateLimiterAllow(t *testing.T) {
+	clock := NewManualClock(mustParse(t, "2024-12-14T12:00:00Z"))
+	l, err := NewRateLimiter(clock, Second, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if !l.Allow() {
+			t.Fatalf("Allow() #%d = false, expected burst of 3", i+1)
+		}
+	}
+	if l.Allow() {
+		t.Error("Allow() after burst = true, expected false")
+	}
+	if got := l.Tokens(); got != 0 {
+		t.Errorf("Tokens() = %d, expected 0", got)
+	}
+
+	clock.Advance(Second)
+	if !l.Allow() {
+		t.Error("Allow() after refill = false, expected true")
+	}
+	if l.Allow() {
+		t.Error("Allow() = true, expected only one token refilled")
+	}
+
+	clock.Advance(Hour)
+	if got := l.Tokens(); got != 3 {
+		t.Errorf("Tokens() = %d, expected bucket capped at 3", got)
+	}
+	if l.AllowN(4) {
+		t.Error("AllowN(4) = true, expected false above burst")
+	}
+	if got := l.Tokens(); got != 3 {
+		t.Errorf("Tokens() after refused AllowN = %d, expected 3", got)
+	}
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	clock := NewManualClock(start)
+	l, _ := NewRateLimiter(clock, 100*Millisecond, 2)
+
+	expected := []Timestamp{start, start, start + Timestamp(100*Millisecond), start + Timestamp(200*Millisecond)}
+	for i, want := range expected {
+		if got := l.Reserve(); got != want {
+			t.Errorf("Reserve() #%d = %s, expected %s", i+1, got.Format(), want.Format())
+		}
+	}
+
+	if _, err := l.ReserveN(3); err == nil {
+		t.Error("ReserveN(3) should fail with burst 2")
+	}
+	clock.Advance(Second)
+	if got, _ := l.ReserveN(2); got != clock.Now() {
+		t.Errorf("ReserveN(2) = %s, expected now", got.Format())
+	}
+}
+
+func TestNewRateLimiterInvalid(t *testing.T) {
+	if _, err := NewRateLimiter(nil, 0, 1); err == nil {
+		t.Error("NewRateLimiter(0, 1) should fail")
+	}
+	if _, err := NewRateLimiter(nil, Second, 0); err == nil {
+		t.Error("NewRateLimiter(1s, 0) should fail")
+	}
+}
+
+func TestRateLimiterNonPositive(t *testing.T) {
+	l, _ := NewRateLimiter(NewManualClock(mustParse(t, "2024-12-14T12:00:00Z")), Second, 2)
+
+	for _, n := range []int{0, -1} {
+		if l.AllowN(n) {
+			t.Errorf("AllowN(%d) = true, expected false", n)
+		}
+		if _, err := l.ReserveN(n); err == nil {
+			t.Errorf("ReserveN(%d) should fail", n)
+		}
+	}
+	if got := l.Tokens(); got != 2 {
+		t.Errorf("Tokens() after non-positive requests = %d, expected 2", got)
+	}
+}
@@ -0,0 +1,90 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// RateMeter records event timestamps and reports event rates over sliding
+// windows ending at the clock's current time. Events older than the
+// longest window are discarded. It is safe for concurrent use.
+type RateMeter struct {
+	mu        sync.Mutex
+	clock     Clock
+	retention Duration
+	events    []Timestamp
+}
+
+// NewRateMeter returns a RateMeter that can report rates over windows of up
+// to retention. A nil clock uses DefaultClock.
+func NewRateMeter(clock Clock, retention Duration) (*RateMeter, error) {
+	if retention <= 0 {
+		return nil, errors.New("rate meter retention must be positive")
+	}
+	return &RateMeter{clock: clockOrSystem(clock), retention: retention}, nil
+}
+
+// Mark records an event at the clock's current time.
+func (m *RateMeter) Mark() {
+	m.Record(m.clock.Now())
+}
+
+// Record records an event at ts. Events may arrive out of order; those
+// already older than the retention are ignored.
+func (m *RateMeter) Record(ts Timestamp) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.clock.Now()
+	m.prune(now)
+	if ts <= now-Timestamp(m.retention) {
+		return
+	}
+	if n := len(m.events); n == 0 || m.events[n-1] <= ts {
+		m.events = append(m.events, ts)
+		return
+	}
+	i := sort.Search(len(m.events), func(i int) bool { return m.events[i] > ts })
+	m.events = append(m.events, 0)
+	copy(m.events[i+1:], m.events[i:])
+	m.events[i] = ts
+}
+
+// Count returns the number of events in the window (now-window, now].
+// Windows longer than the retention are shortened to it.
+func (m *RateMeter) Count(window Duration) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.clock.Now()
+	m.prune(now)
+	if window > m.retention {
+		window = m.retention
+	}
+	from := sort.Search(len(m.events), func(i int) bool { return m.events[i] > now-Timestamp(window) })
+	to := sort.Search(len(m.events), func(i int) bool { return m.events[i] > now })
+	return to - from
+}
+
+// Rate returns the events per second over the window ending now, as
+// Count(window) divided by the window length. It returns 0 for a
+// non-positive window.
+func (m *RateMeter) Rate(window Duration) float64 {
+	if window <= 0 {
+		return 0
+	}
+	if window > m.retention {
+		window = m.retention
+	}
+	return float64(m.Count(window)) / (float64(window) / float64(Second))
+}
+
+// prune drops events that have left the retention window.
+func (m *RateMeter) prune(now Timestamp) {
+	cutoff := now - Timestamp(m.retention)
+	i := sort.Search(len(m.events), func(i int) bool { return m.events[i] > cutoff })
+	if i > 0 {
+		m.events = append(m.events[:0], m.events[i:]...)
+	}
+}
@@ -0,0 +1,59 @@
+package universal_timestamp
+
+import "testing"
+
+func TestRateMeter(t *testing.T) {
+	clock := NewManualClock(mustParse(t, "2024-12-14T12:00:00Z"))
+	m, err := NewRateMeter(clock, Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 10; i++ {
+		clock.Advance(100 * Millisecond)
+		m.Mark()
+	}
+	if got := m.Count(Second); got != 10 {
+		t.Errorf("Count(1s) = %d, expected 10", got)
+	}
+	if got := m.Rate(Second); got != 10 {
+		t.Errorf("Rate(1s) = %v, expected 10", got)
+	}
+	if got := m.Rate(10 * Second); got != 1 {
+		t.Errorf("Rate(10s) = %v, expected 1", got)
+	}
+	if got := m.Count(500 * Millisecond); got != 5 {
+		t.Errorf("Count(500ms) = %d, expected 5", got)
+	}
+
+	clock.Advance(Minute)
+	if got := m.Count(Minute); got != 0 {
+		t.Errorf("Count() after retention = %d, expected 0", got)
+	}
+}
+
+func TestRateMeterOutOfOrder(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	clock := NewManualClock(start + Timestamp(10*Second))
+	m, _ := NewRateMeter(clock, 5*Second)
+
+	m.Record(start + Timestamp(9*Second))
+	m.Record(start + Timestamp(7*Second))
+	m.Record(start + Timestamp(8*Second))
+	m.Record(start)
+	if got := m.Count(5 * Second); got != 3 {
+		t.Errorf("Count(5s) = %d, expected 3", got)
+	}
+	if got := m.Count(2500 * Millisecond); got != 2 {
+		t.Errorf("Count(2.5s) = %d, expected 2", got)
+	}
+	if got := m.Rate(Hour); got != 3.0/5 {
+		t.Errorf("Rate(1h) = %v, expected rate over the 5s retention", got)
+	}
+}
+
+func TestNewRateMeterInvalid(t *testing.T) {
+	if _, err := NewRateMeter(nil, 0); err == nil {
+		t.Error("NewRateMeter(0) should fail")
+	}
+}
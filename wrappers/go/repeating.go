@@ -0,0 +1,127 @@
+package universal_timestamp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RepeatingInterval is an ISO-8601 repeating interval such as
+// "R5/2024-12-01T00:00:00Z/PT1H": a run of back-to-back intervals of the
+// same length.
+type RepeatingInterval struct {
+	// Repetitions is the number of occurrences, or -1 for an unbounded
+	// series.
+	Repetitions int
+	// Anchor is the start of the first occurrence, or its end if Backward.
+	Anchor Timestamp
+	// Period and Duration are the calendar and clock parts of each
+	// occurrence's length. Calendar parts are applied in UTC.
+	Period   Period
+	Duration Duration
+	// Backward reports that occurrences run back in time from Anchor, as
+	// written in "duration/end" form.
+	Backward bool
+}
+
+// ParseRepeatingInterval parses an ISO-8601 repeating interval:
+// "Rn/" followed by an interval in any form ParseInterval accepts, such as
+// "R5/2024-12-01T00:00:00Z/PT1H" or "R3/P1M/2025-01-01T00:00:00Z". n is
+// the number of occurrences; "R/" and "R-1/" denote an unbounded series.
+// In "start/end" form each occurrence has the length of the given interval,
+// and in "duration/end" form occurrences run backwards from end. opts are
+// passed on to Parse for the timestamp. Empty intervals, which would repeat
+// the same instant, return ErrInvalidFormat.
+func ParseRepeatingInterval(s string, opts ...ParseOption) (RepeatingInterval, error) {
+	count, rest, ok := strings.Cut(s, "/")
+	if !ok || count == "" || count[0] != 'R' && count[0] != 'r' {
+		return RepeatingInterval{}, fmt.Errorf("%w: repeating interval %q", ErrInvalidFormat, s)
+	}
+
+	r := RepeatingInterval{Repetitions: -1}
+	if n := count[1:]; n != "" && n != "-1" {
+		for i := 0; i < len(n); i++ {
+			if !isDigit(n[i]) {
+				return RepeatingInterval{}, fmt.Errorf("%w: repetitions %q", ErrInvalidFormat, count)
+			}
+		}
+		reps, err := strconv.Atoi(n)
+		if err != nil {
+			return RepeatingInterval{}, fmt.Errorf("%w: repetitions %q", ErrOutOfRange, count)
+		}
+		r.Repetitions = reps
+	}
+
+	iv, err := ParseInterval(rest, opts...)
+	if err != nil {
+		return RepeatingInterval{}, err
+	}
+	if iv.IsEmpty() {
+		return RepeatingInterval{}, fmt.Errorf("%w: empty repeating interval %q", ErrInvalidFormat, s)
+	}
+	parts := strings.Split(rest, "/")
+	switch {
+	case isISODuration(parts[1]):
+		r.Anchor = iv.Start
+		r.Period, r.Duration, _ = ParseISODuration(parts[1])
+	case isISODuration(parts[0]):
+		r.Anchor, r.Backward = iv.End, true
+		r.Period, r.Duration, _ = ParseISODuration(parts[0])
+	default:
+		r.Anchor, r.Duration = iv.Start, iv.Duration()
+	}
+	return r, nil
+}
+
+// Occurrence returns the k-th occurrence, counting from 0, whether or not
+// k is within Repetitions. Calendar steps are measured from Anchor, so a
+// monthly series starting on the 31st falls on the last day of shorter
+// months without drifting.
+func (r RepeatingInterval) Occurrence(k int) Interval {
+	if r.Backward {
+		return Interval{Start: r.boundary(k + 1), End: r.boundary(k)}
+	}
+	return Interval{Start: r.boundary(k), End: r.boundary(k + 1)}
+}
+
+// boundary returns the instant k occurrence lengths from Anchor, in the
+// direction of the series.
+func (r RepeatingInterval) boundary(k int) Timestamp {
+	p := Period{Years: r.Period.Years * k, Months: r.Period.Months * k, Days: r.Period.Days * k}
+	d := Timestamp(r.Duration) * Timestamp(k)
+	if r.Backward {
+		return (r.Anchor - d).AddPeriod(p.Negate(), nil)
+	}
+	return r.Anchor.AddPeriod(p, nil) + d
+}
+
+// Occurrences returns an Iterator over the occurrences in series order,
+// which is reverse chronological if Backward. An unbounded series ends
+// only when its occurrences leave the representable range.
+func (r RepeatingInterval) Occurrences() Iterator[Interval] {
+	return &repeatingIterator{r: r}
+}
+
+// repeatingIterator yields the occurrences of a RepeatingInterval.
+type repeatingIterator struct {
+	r    RepeatingInterval
+	k    int
+	prev Interval
+	done bool
+}
+
+func (it *repeatingIterator) Next() (Interval, bool) {
+	if it.done || it.r.Repetitions >= 0 && it.k >= it.r.Repetitions {
+		return Interval{}, false
+	}
+	iv := it.r.Occurrence(it.k)
+	// Occurrences must keep moving in the series' direction; anything else
+	// means a boundary overflowed.
+	if iv.IsEmpty() || it.k > 0 && (it.r.Backward && iv.End > it.prev.Start || !it.r.Backward && iv.Start < it.prev.End) {
+		it.done = true
+		return Interval{}, false
+	}
+	it.prev = iv
+	it.k++
+	return iv, true
+}
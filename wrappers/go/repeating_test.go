@@ -0,0 +1,97 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseRepeatingInterval(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected []string
+	}{
+		{"R3/2024-12-01T00:00:00Z/PT1H", []string{
+			"2024-12-01T00:00:00Z/2024-12-01T01:00:00Z",
+			"2024-12-01T01:00:00Z/2024-12-01T02:00:00Z",
+			"2024-12-01T02:00:00Z/2024-12-01T03:00:00Z",
+		}},
+		{"R2/2024-12-01T00:00:00Z/2024-12-01T00:30:00Z", []string{
+			"2024-12-01T00:00:00Z/2024-12-01T00:30:00Z",
+			"2024-12-01T00:30:00Z/2024-12-01T01:00:00Z",
+		}},
+		// Monthly steps from the 31st stay anchored to the 31st.
+		{"R4/2024-01-31T00:00:00Z/P1M", []string{
+			"2024-01-31T00:00:00Z/2024-02-29T00:00:00Z",
+			"2024-02-29T00:00:00Z/2024-03-31T00:00:00Z",
+			"2024-03-31T00:00:00Z/2024-04-30T00:00:00Z",
+			"2024-04-30T00:00:00Z/2024-05-31T00:00:00Z",
+		}},
+		{"R2/P1D/2025-01-01T00:00:00Z", []string{
+			"2024-12-31T00:00:00Z/2025-01-01T00:00:00Z",
+			"2024-12-30T00:00:00Z/2024-12-31T00:00:00Z",
+		}},
+		{"R0/2024-12-01T00:00:00Z/PT1H", nil},
+	}
+	for _, c := range cases {
+		r, err := ParseRepeatingInterval(c.in)
+		if err != nil {
+			t.Errorf("ParseRepeatingInterval(%q) error: %v", c.in, err)
+			continue
+		}
+		var got []string
+		for _, iv := range Collect(r.Occurrences()) {
+			got = append(got, iv.String())
+		}
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("ParseRepeatingInterval(%q) occurrences = %v, expected %v", c.in, got, c.expected)
+		}
+	}
+}
+
+func TestRepeatingIntervalUnbounded(t *testing.T) {
+	for _, s := range []string{"R/2024-12-01T00:00:00Z/PT1H", "R-1/2024-12-01T00:00:00Z/PT1H"} {
+		r, err := ParseRepeatingInterval(s)
+		if err != nil {
+			t.Fatalf("ParseRepeatingInterval(%q) error: %v", s, err)
+		}
+		if r.Repetitions != -1 {
+			t.Errorf("ParseRepeatingInterval(%q).Repetitions = %d, expected -1", s, r.Repetitions)
+		}
+		it := r.Occurrences()
+		for i := 0; i < 1000; i++ {
+			if _, ok := it.Next(); !ok {
+				t.Fatalf("unbounded series ended after %d occurrences", i)
+			}
+		}
+		if got := r.Occurrence(1000).Start.Format(); got != "2025-01-11T16:00:00Z" {
+			t.Errorf("Occurrence(1000).Start = %s, expected 2025-01-11T16:00:00Z", got)
+		}
+	}
+
+	// An unbounded series stops at the end of the representable range.
+	r, err := ParseRepeatingInterval("R/2261-01-01T00:00:00Z/P1Y")
+	if err != nil {
+		t.Fatalf("ParseRepeatingInterval() error: %v", err)
+	}
+	if got := len(Collect(r.Occurrences())); got != 1 {
+		t.Errorf("occurrences before the end of the range = %d, expected 1", got)
+	}
+}
+
+func TestParseRepeatingIntervalInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"2024-12-01T00:00:00Z/PT1H",
+		"R5",
+		"Rx/2024-12-01T00:00:00Z/PT1H",
+		"R-2/2024-12-01T00:00:00Z/PT1H",
+		"R5/2024-12-01T00:00:00Z/PT0S",
+		"R5/2024-12-01T00:00:00Z",
+		"R5/PT1H/PT1H",
+	} {
+		if _, err := ParseRepeatingInterval(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseRepeatingInterval(%q) error = %v, expected ErrInvalidFormat", s, err)
+		}
+	}
+}
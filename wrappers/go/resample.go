@@ -0,0 +1,77 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"sort"
+)
+
+// TimedValue is an observation at an instant.
+type TimedValue struct {
+	Time  Timestamp
+	Value float64
+}
+
+// Aggregation selects how Resample combines the observations that fall in
+// one grid slot.
+type Aggregation int
+
+const (
+	// AggregateLast keeps the latest observation in each slot.
+	AggregateLast Aggregation = iota
+	// AggregateFirst keeps the earliest observation in each slot.
+	AggregateFirst
+	// AggregateMean averages the observations in each slot.
+	AggregateMean
+)
+
+// Resample aligns irregular observations onto a grid of slots every apart,
+// aligned to the Unix epoch. Each output point is stamped with the start of
+// its slot. Slots between the first and last observation that received no
+// observations repeat the previous slot's value, so the result has no
+// holes. points need not be sorted and is not modified.
+func Resample(points []TimedValue, every Duration, agg Aggregation) ([]TimedValue, error) {
+	if every <= 0 {
+		return nil, errors.New("resample interval must be positive")
+	}
+	if len(points) == 0 {
+		return nil, nil
+	}
+
+	sorted := append([]TimedValue(nil), points...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Time < sorted[j].Time })
+
+	slotOf := func(ts Timestamp) Timestamp {
+		return Timestamp(floorMultiple(int64(ts), int64(every)))
+	}
+
+	var out []TimedValue
+	for i := 0; i < len(sorted); {
+		slot := slotOf(sorted[i].Time)
+		j := i
+		sum := 0.0
+		for j < len(sorted) && slotOf(sorted[j].Time) == slot {
+			sum += sorted[j].Value
+			j++
+		}
+
+		var v float64
+		switch agg {
+		case AggregateFirst:
+			v = sorted[i].Value
+		case AggregateMean:
+			v = sum / float64(j-i)
+		default:
+			v = sorted[j-1].Value
+		}
+
+		if n := len(out); n > 0 {
+			prev := out[n-1]
+			for fill := prev.Time + Timestamp(every); fill < slot; fill += Timestamp(every) {
+				out = append(out, TimedValue{Time: fill, Value: prev.Value})
+			}
+		}
+		out = append(out, TimedValue{Time: slot, Value: v})
+		i = j
+	}
+	return out, nil
+}
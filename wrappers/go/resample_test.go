@@ -0,0 +1,43 @@
+package universal_timestamp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResample(t *testing.T) {
+	base := mustParse(t, "2024-12-14T12:00:00Z")
+	at := func(s int64) Timestamp { return base + Timestamp(s)*Timestamp(Second) }
+
+	points := []TimedValue{
+		{at(12), 3},
+		{at(2), 1},
+		{at(7), 2},
+		{at(41), 10},
+	}
+
+	cases := map[Aggregation][]TimedValue{
+		AggregateLast:  {{at(0), 2}, {at(10), 3}, {at(20), 3}, {at(30), 3}, {at(40), 10}},
+		AggregateFirst: {{at(0), 1}, {at(10), 3}, {at(20), 3}, {at(30), 3}, {at(40), 10}},
+		AggregateMean:  {{at(0), 1.5}, {at(10), 3}, {at(20), 3}, {at(30), 3}, {at(40), 10}},
+	}
+	for agg, expected := range cases {
+		got, err := Resample(points, 10*Second, agg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Resample(%d) = %v, expected %v", agg, got, expected)
+		}
+	}
+
+	if points[0].Time != at(12) {
+		t.Error("Resample() modified its input")
+	}
+	if got, err := Resample(nil, Second, AggregateLast); err != nil || got != nil {
+		t.Errorf("Resample(nil) = %v, %v", got, err)
+	}
+	if _, err := Resample(points, 0, AggregateLast); err == nil {
+		t.Error("Resample() with zero interval succeeded")
+	}
+}
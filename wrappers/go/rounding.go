@@ -0,0 +1,105 @@
+package universal_timestamp
+
+import (
+	"math"
+	"strconv"
+)
+
+// RoundingMode selects how precision is reduced when a timestamp is
+// rounded to a coarser unit.
+type RoundingMode int
+
+const (
+	// RoundFloor rounds towards the earlier instant, so instants before
+	// 1970 move away from the epoch. It is the zero value and matches the
+	// truncating behaviour of earlier releases.
+	RoundFloor RoundingMode = iota
+	// RoundCeiling rounds towards the later instant.
+	RoundCeiling
+	// RoundHalfUp rounds to the nearest multiple, with ties going to the
+	// later instant.
+	RoundHalfUp
+	// RoundHalfEven rounds to the nearest multiple, with ties going to the
+	// even multiple of the unit, as required by most financial systems.
+	RoundHalfEven
+)
+
+var roundingModeNames = [...]string{
+	RoundFloor:    "floor",
+	RoundCeiling:  "ceiling",
+	RoundHalfUp:   "half-up",
+	RoundHalfEven: "half-even",
+}
+
+// String returns the mode's name, such as "half-even".
+func (m RoundingMode) String() string {
+	if m >= 0 && int(m) < len(roundingModeNames) {
+		return roundingModeNames[m]
+	}
+	return "RoundingMode(" + strconv.Itoa(int(m)) + ")"
+}
+
+// Round returns t rounded to a multiple of unit since the Unix epoch
+// according to mode. A non-positive unit returns t unchanged. When the
+// rounded value would leave the Timestamp range, the nearest multiple
+// inside it is returned instead.
+func (t Timestamp) Round(unit Duration, mode RoundingMode) Timestamp {
+	if unit <= 0 {
+		return t
+	}
+	return Timestamp(mulSaturating(roundDiv(int64(t), int64(unit), mode), int64(unit)))
+}
+
+// floorMultiple floors n to a multiple of unit, which must be positive.
+// Near math.MinInt64, where that multiple is out of range, the smallest
+// multiple in range is returned instead.
+func floorMultiple(n, unit int64) int64 {
+	return mulSaturating(floorDiv(n, unit), unit)
+}
+
+// mulSaturating returns q*unit for a positive unit, clamping q so that the
+// product stays within the int64 range.
+func mulSaturating(q, unit int64) int64 {
+	if q > math.MaxInt64/unit {
+		q = math.MaxInt64 / unit
+	} else if q < math.MinInt64/unit {
+		q = math.MinInt64 / unit
+	}
+	return q * unit
+}
+
+// roundDiv divides n by unit, which must be positive, rounding the
+// quotient according to mode.
+func roundDiv(n, unit int64, mode RoundingMode) int64 {
+	q := floorDiv(n, unit)
+	r := n - q*unit
+	if r == 0 {
+		return q
+	}
+	switch mode {
+	case RoundCeiling:
+		q++
+	case RoundHalfUp:
+		if r >= unit-r {
+			q++
+		}
+	case RoundHalfEven:
+		if r > unit-r || (r == unit-r && q&1 != 0) {
+			q++
+		}
+	}
+	return q
+}
+
+// roundDigits rounds t to the given number (0-9) of fractional-second
+// digits according to mode.
+func roundDigits(t Timestamp, digits int, mode RoundingMode) Timestamp {
+	if mode == RoundFloor || digits >= 9 {
+		return t
+	}
+	unit := Duration(1)
+	for i := digits; i < 9; i++ {
+		unit *= 10
+	}
+	return t.Round(unit, mode)
+}
@@ -0,0 +1,114 @@
+package universal_timestamp
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		ts       Timestamp
+		mode     RoundingMode
+		expected Timestamp
+	}{
+		{1500, RoundFloor, 1000},
+		{1500, RoundCeiling, 2000},
+		{1500, RoundHalfUp, 2000},
+		{1500, RoundHalfEven, 2000},
+		{2500, RoundHalfUp, 3000},
+		{2500, RoundHalfEven, 2000},
+		{2499, RoundHalfUp, 2000},
+		{2501, RoundHalfEven, 3000},
+		{-1500, RoundFloor, -2000},
+		{-1500, RoundCeiling, -1000},
+		{-1500, RoundHalfUp, -1000},
+		{-1500, RoundHalfEven, -2000},
+		{-2500, RoundHalfEven, -2000},
+		{3000, RoundCeiling, 3000},
+	}
+	for _, tt := range tests {
+		if got := tt.ts.Round(1000, tt.mode); got != tt.expected {
+			t.Errorf("Timestamp(%d).Round(1000, %s) = %d, expected %d", tt.ts, tt.mode, got, tt.expected)
+		}
+	}
+
+	if got := Timestamp(1234).Round(0, RoundCeiling); got != 1234 {
+		t.Errorf("Round(0) = %d, expected 1234", got)
+	}
+	if got := Timestamp(math.MaxInt64).Round(Second, RoundCeiling); got != math.MaxInt64/Timestamp(Second)*Timestamp(Second) {
+		t.Errorf("Round at MaxInt64 = %d", got)
+	}
+	if got := Timestamp(math.MinInt64).Round(Second, RoundFloor); got != math.MinInt64/Timestamp(Second)*Timestamp(Second) {
+		t.Errorf("Round at MinInt64 = %d", got)
+	}
+}
+
+func TestRoundingModeString(t *testing.T) {
+	if s := RoundHalfEven.String(); s != "half-even" {
+		t.Errorf("RoundHalfEven.String() = %s, expected half-even", s)
+	}
+	if s := RoundingMode(9).String(); s != "RoundingMode(9)" {
+		t.Errorf("RoundingMode(9).String() = %s", s)
+	}
+}
+
+func TestCodecRounding(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00.0125Z")
+
+	tests := []struct {
+		cfg      Config
+		expected string
+	}{
+		{Config{Precision: 3}, "2024-12-14T12:00:00.012Z"},
+		{Config{Precision: 3, Rounding: RoundHalfEven}, "2024-12-14T12:00:00.012Z"},
+		{Config{Precision: 3, Rounding: RoundHalfUp}, "2024-12-14T12:00:00.013Z"},
+		{Config{Precision: 2, Rounding: RoundCeiling}, "2024-12-14T12:00:00.02Z"},
+		{Config{Precision: PrecisionSeconds, Rounding: RoundCeiling}, "2024-12-14T12:00:01Z"},
+		{Config{Rounding: RoundCeiling}, "2024-12-14T12:00:00.0125Z"},
+	}
+	for _, tt := range tests {
+		c := NewCodec(tt.cfg)
+		if got := c.Format(ts); got != tt.expected {
+			t.Errorf("Format(%+v) = %s, expected %s", tt.cfg, got, tt.expected)
+		}
+		if n := c.FormatLen(ts); n != len(tt.expected) {
+			t.Errorf("FormatLen(%+v) = %d, expected %d", tt.cfg, n, len(tt.expected))
+		}
+	}
+
+	carry := mustParse(t, "2024-12-31T23:59:59.9996Z")
+	if got := NewCodec(Config{Precision: 3, Rounding: RoundHalfUp}).Format(carry); got != "2025-01-01T00:00:00.000Z" {
+		t.Errorf("Format with carry = %s, expected 2025-01-01T00:00:00.000Z", got)
+	}
+}
+
+func TestDefaultRounding(t *testing.T) {
+	defer SetDefaults(Config{})
+
+	ts := mustParse(t, "2024-12-14T12:00:00.0025Z")
+	if got := ts.EpochMillis(); got != 1734177600002 {
+		t.Errorf("EpochMillis() = %d, expected 1734177600002", got)
+	}
+
+	SetDefaults(Config{Rounding: RoundHalfEven})
+	if got := ts.EpochMillis(); got != 1734177600002 {
+		t.Errorf("half-even EpochMillis() = %d, expected 1734177600002", got)
+	}
+	if got := (ts + 1000000).EpochMillis(); got != 1734177600004 {
+		t.Errorf("half-even EpochMillis() = %d, expected 1734177600004", got)
+	}
+	if got := ts.FormatPrecision(2); got != "2024-12-14T12:00:00.00Z" {
+		t.Errorf("half-even FormatPrecision(2) = %s, expected 2024-12-14T12:00:00.00Z", got)
+	}
+
+	SetDefaults(Config{Rounding: RoundCeiling})
+	if got := ts.EpochSeconds(); got != 1734177601 {
+		t.Errorf("ceiling EpochSeconds() = %d, expected 1734177601", got)
+	}
+	if got := ts.EpochMicros(); got != 1734177600002500 {
+		t.Errorf("ceiling EpochMicros() = %d, expected 1734177600002500", got)
+	}
+	if got := ts.FormatPrecision(2); got != "2024-12-14T12:00:00.01Z" {
+		t.Errorf("ceiling FormatPrecision(2) = %s, expected 2024-12-14T12:00:00.01Z", got)
+	}
+}
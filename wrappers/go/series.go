@@ -0,0 +1,63 @@
+package universal_timestamp
+
+import "encoding/binary"
+
+// EncodeSeries compresses ts using delta-of-delta encoding with varints, as
+// in Gorilla. Regularly spaced timestamps cost about one byte each. The
+// slice does not have to be sorted, but sorted input compresses best.
+func EncodeSeries(ts []Timestamp) []byte {
+	return AppendSeries(nil, ts)
+}
+
+// AppendSeries appends the EncodeSeries form of ts to dst.
+func AppendSeries(dst []byte, ts []Timestamp) []byte {
+	ReportBatch("AppendSeries", len(ts))
+	dst = binary.AppendUvarint(dst, uint64(len(ts)))
+	var prev, prevDelta int64
+	for i, t := range ts {
+		switch i {
+		case 0:
+			dst = binary.AppendVarint(dst, int64(t))
+		default:
+			// Wrapping arithmetic is fine: DecodeSeries wraps the same way.
+			delta := int64(t) - prev
+			dst = binary.AppendVarint(dst, delta-prevDelta)
+			prevDelta = delta
+		}
+		prev = int64(t)
+	}
+	return dst
+}
+
+// DecodeSeries decodes a series produced by EncodeSeries. It returns
+// ErrInvalidFormat if b is truncated or has trailing bytes.
+func DecodeSeries(b []byte) ([]Timestamp, error) {
+	n, k := binary.Uvarint(b)
+	if k <= 0 || n > uint64(len(b)-k) {
+		return nil, ErrInvalidFormat
+	}
+	b = b[k:]
+
+	ts := make([]Timestamp, 0, n)
+	var prev, prevDelta int64
+	for i := uint64(0); i < n; i++ {
+		v, k := binary.Varint(b)
+		if k <= 0 {
+			return nil, ErrInvalidFormat
+		}
+		b = b[k:]
+
+		if i == 0 {
+			prev = v
+		} else {
+			prevDelta += v
+			prev += prevDelta
+		}
+		ts = append(ts, Timestamp(prev))
+	}
+	if len(b) != 0 {
+		return nil, ErrInvalidFormat
+	}
+	ReportBatch("DecodeSeries", len(ts))
+	return ts, nil
+}
@@ -0,0 +1,56 @@
+package universal_timestamp
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSeriesRoundTrip(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	regular := make([]Timestamp, 1000)
+	for i := range regular {
+		regular[i] = start + Timestamp(i)*Timestamp(Second)
+	}
+
+	cases := [][]Timestamp{
+		nil,
+		{start},
+		regular,
+		{start, start + 7, start + 3, start - Timestamp(Hour)},
+		{math.MinInt64, math.MaxInt64, 0, math.MinInt64},
+	}
+	for _, ts := range cases {
+		got, err := DecodeSeries(EncodeSeries(ts))
+		if err != nil {
+			t.Errorf("DecodeSeries() failed: %v", err)
+			continue
+		}
+		if len(ts) == 0 && len(got) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, ts) {
+			t.Errorf("DecodeSeries() = %v, expected %v", got, ts)
+		}
+	}
+
+	// A regular series needs one byte per delta-of-delta after the header.
+	if n := len(EncodeSeries(regular)); n > len(regular)+16 {
+		t.Errorf("EncodeSeries() of a regular series used %d bytes", n)
+	}
+}
+
+func TestDecodeSeriesErrors(t *testing.T) {
+	valid := EncodeSeries([]Timestamp{1, 2, 3})
+	inputs := [][]byte{
+		nil,
+		valid[:len(valid)-1],
+		append(append([]byte{}, valid...), 0),
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+	}
+	for _, b := range inputs {
+		if _, err := DecodeSeries(b); err != ErrInvalidFormat {
+			t.Errorf("DecodeSeries(%x) = %v, expected ErrInvalidFormat", b, err)
+		}
+	}
+}
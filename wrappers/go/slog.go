@@ -0,0 +1,44 @@
+//go:build go1.21
+
+package universal_timestamp
+
+import (
+	"log/slog"
+	"sync/atomic"
+)
+
+// logPrecision holds the fractional digits used by LogValue, or -1 for the
+// canonical Format output.
+var logPrecision atomic.Int32
+
+func init() {
+	logPrecision.Store(-1)
+}
+
+// SetLogPrecision sets the number of fractional-second digits (0-9) used
+// when timestamps are written to structured logs. A negative value restores
+// the canonical Format output, which omits trailing zeros.
+func SetLogPrecision(digits int) {
+	if digits > 9 {
+		digits = 9
+	}
+	if digits < 0 {
+		digits = -1
+	}
+	logPrecision.Store(int32(digits))
+}
+
+// LogValue implements slog.LogValuer, rendering the timestamp as an
+// ISO-8601 string.
+func (t Timestamp) LogValue() slog.Value {
+	if digits := logPrecision.Load(); digits >= 0 {
+		return slog.StringValue(t.FormatPrecision(int(digits)))
+	}
+	return slog.StringValue(t.Format())
+}
+
+// LogValue implements slog.LogValuer, rendering the duration in ISO-8601
+// notation.
+func (d Duration) LogValue() slog.Value {
+	return slog.StringValue(d.String())
+}
@@ -0,0 +1,41 @@
+//go:build go1.21
+
+package universal_timestamp
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	ts := mustParse(t, "2024-12-14T12:00:00.5Z")
+	logger.Info("event", "at", ts, "took", 90*Second)
+
+	out := buf.String()
+	if !strings.Contains(out, "at=2024-12-14T12:00:00.5Z") {
+		t.Errorf("timestamp not rendered canonically: %s", out)
+	}
+	if !strings.Contains(out, "took=PT1M30S") {
+		t.Errorf("duration not rendered in ISO form: %s", out)
+	}
+}
+
+func TestSetLogPrecision(t *testing.T) {
+	defer SetLogPrecision(-1)
+
+	ts := mustParse(t, "2024-12-14T12:00:00.5Z")
+	SetLogPrecision(3)
+	if got := ts.LogValue().String(); got != "2024-12-14T12:00:00.500Z" {
+		t.Errorf("LogValue() with precision 3 = %s", got)
+	}
+
+	SetLogPrecision(0)
+	if got := ts.LogValue().String(); got != "2024-12-14T12:00:00Z" {
+		t.Errorf("LogValue() with precision 0 = %s", got)
+	}
+}
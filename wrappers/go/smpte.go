@@ -0,0 +1,243 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FrameRate is a video frame rate of Num/Den frames per second, such as
+// 30000/1001 for NTSC's 29.97. DropFrame selects drop-frame timecode, which
+// skips frame numbers so that timecode at 29.97 or 59.94 keeps pace with
+// the wall clock; it applies only to rates whose nominal rate is a multiple
+// of 30. Num and Den must be positive.
+type FrameRate struct {
+	Num, Den  int64
+	DropFrame bool
+}
+
+// Common frame rates.
+var (
+	FPS23976  = FrameRate{Num: 24000, Den: 1001}
+	FPS24     = FrameRate{Num: 24, Den: 1}
+	FPS25     = FrameRate{Num: 25, Den: 1}
+	FPS2997   = FrameRate{Num: 30000, Den: 1001}
+	FPS2997DF = FrameRate{Num: 30000, Den: 1001, DropFrame: true}
+	FPS30     = FrameRate{Num: 30, Den: 1}
+	FPS50     = FrameRate{Num: 50, Den: 1}
+	FPS5994   = FrameRate{Num: 60000, Den: 1001}
+	FPS5994DF = FrameRate{Num: 60000, Den: 1001, DropFrame: true}
+	FPS60     = FrameRate{Num: 60, Den: 1}
+)
+
+// Timecode is an SMPTE timecode, HH:MM:SS:FF. Hours wrap at 24, as on a
+// time-of-day timecode.
+type Timecode struct {
+	Hours, Minutes, Seconds, Frames int
+	// DropFrame reports whether the timecode counts drop-frame, which is
+	// written with a ";" before the frames.
+	DropFrame bool
+}
+
+// String formats the timecode as "01:02:03:04", or "01:02:03;04" for
+// drop-frame.
+func (tc Timecode) String() string {
+	sep := ":"
+	if tc.DropFrame {
+		sep = ";"
+	}
+	return fmt.Sprintf("%02d:%02d:%02d%s%02d", tc.Hours, tc.Minutes, tc.Seconds, sep, tc.Frames)
+}
+
+// ParseTimecode parses "HH:MM:SS:FF". A ";" or "." before the frames
+// marks drop-frame timecode. Frames are checked against
+// a frame rate by FrameRate.FrameNumber, not here. It returns
+// ErrInvalidFormat for other input.
+func ParseTimecode(s string) (Timecode, error) {
+	if len(s) != 11 {
+		return Timecode{}, fmt.Errorf("%w: timecode %q", ErrInvalidFormat, s)
+	}
+	var fields [4]int
+	for i := range fields {
+		a, b := s[3*i], s[3*i+1]
+		if !isDigit(a) || !isDigit(b) {
+			return Timecode{}, fmt.Errorf("%w: timecode %q", ErrInvalidFormat, s)
+		}
+		fields[i] = atoiDigits([]byte{a, b})
+		if i < 3 && s[3*i+2] != ':' && s[3*i+2] != ';' && s[3*i+2] != '.' {
+			return Timecode{}, fmt.Errorf("%w: timecode %q", ErrInvalidFormat, s)
+		}
+	}
+	tc := Timecode{Hours: fields[0], Minutes: fields[1], Seconds: fields[2], Frames: fields[3], DropFrame: s[8] != ':'}
+	if tc.Hours > 23 || tc.Minutes > 59 || tc.Seconds > 59 {
+		return Timecode{}, fmt.Errorf("%w: timecode %q", ErrInvalidFormat, s)
+	}
+	return tc, nil
+}
+
+// String formats the rate to at most three decimals, as "29.97" or "25",
+// followed by " DF" for drop-frame.
+func (r FrameRate) String() string {
+	s := strconv.FormatFloat(float64(r.Num)/float64(r.Den), 'f', 3, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if r.DropFrame {
+		s += " DF"
+	}
+	return s
+}
+
+// Nominal returns the whole number of frames counted per timecode second:
+// 30 for 29.97 and 24 for 23.976.
+func (r FrameRate) Nominal() int64 {
+	return (r.Num + r.Den - 1) / r.Den
+}
+
+// Frames returns the number of the frame in progress d after the first
+// frame began.
+func (r FrameRate) Frames(d Duration) int64 {
+	return mulDivFloor(int64(d), r.Num, r.Den*int64(Second))
+}
+
+// Duration returns the offset of the first nanosecond of the given frame
+// from the start of the first, so that Frames(Duration(n)) is n. It
+// saturates at the limits of Duration.
+func (r FrameRate) Duration(frames int64) Duration {
+	return Duration(-mulDivFloor(-frames, r.Den*int64(Second), r.Num))
+}
+
+// Timecode returns the timecode of the given frame number, counting from
+// 00:00:00:00 and wrapping at 24 hours. Negative frame numbers count back
+// from midnight.
+func (r FrameRate) Timecode(frames int64) (Timecode, error) {
+	if err := r.validate(); err != nil {
+		return Timecode{}, err
+	}
+	nominal := r.Nominal()
+	perDay := r.framesPerDay()
+	frames %= perDay
+	if frames < 0 {
+		frames += perDay
+	}
+
+	if r.DropFrame {
+		// Frame numbers 0 and 1 (0-3 at 59.94) are skipped at the start of
+		// every minute except each tenth.
+		drop := nominal / 15
+		per10 := nominal*600 - 9*drop
+		perMinute := nominal*60 - drop
+		tens, rem := frames/per10, frames%per10
+		frames += 9 * drop * tens
+		if rem > drop {
+			frames += drop * ((rem - drop) / perMinute)
+		}
+	}
+
+	return Timecode{
+		Hours:     int(frames / (nominal * 3600)),
+		Minutes:   int(frames / (nominal * 60) % 60),
+		Seconds:   int(frames / nominal % 60),
+		Frames:    int(frames % nominal),
+		DropFrame: r.DropFrame,
+	}, nil
+}
+
+// FrameNumber returns the number of the frame tc labels, the inverse of
+// Timecode. It returns ErrOutOfRange if tc's frames exceed the rate or tc
+// is a frame number that drop-frame counting skips, such as 00:01:00;00,
+// and ErrInvalidFormat if tc's DropFrame does not match r's.
+func (r FrameRate) FrameNumber(tc Timecode) (int64, error) {
+	if err := r.validate(); err != nil {
+		return 0, err
+	}
+	if tc.DropFrame != r.DropFrame {
+		return 0, fmt.Errorf("%w: timecode %s does not match %s", ErrInvalidFormat, tc, r)
+	}
+	nominal := r.Nominal()
+	if tc.Hours < 0 || tc.Hours > 23 || tc.Minutes < 0 || tc.Minutes > 59 || tc.Seconds < 0 || tc.Seconds > 59 ||
+		tc.Frames < 0 || int64(tc.Frames) >= nominal {
+		return 0, fmt.Errorf("%w: timecode %s at %s", ErrOutOfRange, tc, r)
+	}
+
+	frames := (int64(tc.Hours)*3600+int64(tc.Minutes)*60+int64(tc.Seconds))*nominal + int64(tc.Frames)
+	if r.DropFrame {
+		drop := nominal / 15
+		if tc.Seconds == 0 && tc.Minutes%10 != 0 && int64(tc.Frames) < drop {
+			return 0, fmt.Errorf("%w: drop-frame timecode skips %s", ErrOutOfRange, tc)
+		}
+		minutes := int64(tc.Hours)*60 + int64(tc.Minutes)
+		frames -= drop * (minutes - minutes/10)
+	}
+	return frames, nil
+}
+
+// TimecodeOf returns the time-of-day timecode of the frame in progress at
+// ts, counting frames from midnight in loc. A nil loc is treated as UTC.
+func (r FrameRate) TimecodeOf(ts Timestamp, loc *time.Location) (Timecode, error) {
+	return r.Timecode(r.Frames(ts.NanosSinceMidnight(loc)))
+}
+
+// TimestampOf returns the instant at which the frame labelled by the
+// time-of-day timecode tc begins on the day containing day in loc. A nil
+// loc is treated as UTC.
+func (r FrameRate) TimestampOf(tc Timecode, day Timestamp, loc *time.Location) (Timestamp, error) {
+	frames, err := r.FrameNumber(tc)
+	if err != nil {
+		return 0, err
+	}
+	return FromNanosSinceMidnight(day, r.Duration(frames), loc), nil
+}
+
+// validate reports whether r can label frames with timecode.
+func (r FrameRate) validate() error {
+	if r.Num <= 0 || r.Den <= 0 {
+		return errors.New("frame rate must be positive")
+	}
+	if r.DropFrame && r.Nominal()%30 != 0 {
+		return fmt.Errorf("%w: drop-frame timecode is not defined at %s fps", ErrInvalidFormat, r)
+	}
+	return nil
+}
+
+// framesPerDay returns the number of timecode labels in 24 hours.
+func (r FrameRate) framesPerDay() int64 {
+	n := r.Nominal() * 86400
+	if r.DropFrame {
+		n -= r.Nominal() / 15 * 9 * 24 * 6
+	}
+	return n
+}
+
+// mulDivFloor returns a*b/c rounded towards negative infinity, for b and
+// c positive, saturating if the result does not fit in an int64.
+func mulDivFloor(a, b, c int64) int64 {
+	neg := a < 0
+	ua := uint64(a)
+	if neg {
+		ua = -ua
+	}
+	hi, lo := bits.Mul64(ua, uint64(b))
+	if hi >= uint64(c) {
+		if neg {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	q, rem := bits.Div64(hi, lo, uint64(c))
+	if neg {
+		if rem != 0 {
+			q++
+		}
+		if q > 1<<63 {
+			return math.MinInt64
+		}
+		return -int64(q)
+	}
+	if q > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(q)
+}
@@ -0,0 +1,130 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTimecode(t *testing.T) {
+	cases := []struct {
+		rate   FrameRate
+		frames int64
+		tc     string
+	}{
+		{FPS25, 0, "00:00:00:00"},
+		{FPS25, 90000, "01:00:00:00"},
+		{FPS24, 24*3723 + 5, "01:02:03:05"},
+		{FPS2997, 1800, "00:01:00:00"},
+		{FPS2997DF, 1799, "00:00:59;29"},
+		{FPS2997DF, 1800, "00:01:00;02"},
+		{FPS2997DF, 17982, "00:10:00;00"},
+		{FPS2997DF, 107892, "01:00:00;00"},
+		{FPS5994DF, 3600, "00:01:00;04"},
+		{FPS2997DF, -1, "23:59:59;29"},
+	}
+	for _, c := range cases {
+		tc, err := c.rate.Timecode(c.frames)
+		if err != nil {
+			t.Fatalf("Timecode(%d) at %s error: %v", c.frames, c.rate, err)
+		}
+		if tc.String() != c.tc {
+			t.Errorf("Timecode(%d) at %s = %s, expected %s", c.frames, c.rate, tc, c.tc)
+		}
+
+		parsed, err := ParseTimecode(c.tc)
+		if err != nil {
+			t.Fatalf("ParseTimecode(%q) error: %v", c.tc, err)
+		}
+		n, err := c.rate.FrameNumber(parsed)
+		if err != nil {
+			t.Fatalf("FrameNumber(%s) at %s error: %v", c.tc, c.rate, err)
+		}
+		if want := c.frames; want < 0 {
+			if n != c.rate.framesPerDay()+want {
+				t.Errorf("FrameNumber(%s) at %s = %d, expected %d", c.tc, c.rate, n, c.rate.framesPerDay()+want)
+			}
+		} else if n != want {
+			t.Errorf("FrameNumber(%s) at %s = %d, expected %d", c.tc, c.rate, n, want)
+		}
+	}
+}
+
+func TestTimecodeErrors(t *testing.T) {
+	for _, s := range []string{"", "01:00:00", "24:00:00:00", "01:60:00:00", "01:00:00-00", "0a:00:00:00"} {
+		if _, err := ParseTimecode(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseTimecode(%q) error = %v, expected ErrInvalidFormat", s, err)
+		}
+	}
+
+	skipped, _ := ParseTimecode("00:01:00;01")
+	if _, err := FPS2997DF.FrameNumber(skipped); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("FrameNumber(00:01:00;01) error = %v, expected ErrOutOfRange", err)
+	}
+	tooMany, _ := ParseTimecode("00:00:00:25")
+	if _, err := FPS25.FrameNumber(tooMany); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("FrameNumber(00:00:00:25) at 25 error = %v, expected ErrOutOfRange", err)
+	}
+	if _, err := FPS2997.FrameNumber(skipped); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("FrameNumber(drop-frame) at 29.97 error = %v, expected ErrInvalidFormat", err)
+	}
+	if _, err := (FrameRate{Num: 25, Den: 1, DropFrame: true}).Timecode(0); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Timecode() at 25 DF error = %v, expected ErrInvalidFormat", err)
+	}
+	if _, err := (FrameRate{}).Timecode(0); err == nil {
+		t.Errorf("Timecode() at zero rate succeeded, expected an error")
+	}
+}
+
+func TestFrameRateDuration(t *testing.T) {
+	if got := FPS2997.Frames(Second); got != 29 {
+		t.Errorf("Frames(1s) at 29.97 = %d, expected 29", got)
+	}
+	if got := FPS2997.Duration(1); got != 33366667 {
+		t.Errorf("Duration(1) at 29.97 = %d, expected 33366667", got)
+	}
+	for n := int64(0); n < 100000; n += 997 {
+		if got := FPS23976.Frames(FPS23976.Duration(n)); got != n {
+			t.Errorf("Frames(Duration(%d)) at 23.976 = %d", n, got)
+		}
+	}
+	if got := FPS25.Frames(-Millisecond); got != -1 {
+		t.Errorf("Frames(-1ms) at 25 = %d, expected -1", got)
+	}
+
+	names := map[FrameRate]string{FPS23976: "23.976", FPS25: "25", FPS2997DF: "29.97 DF", FPS5994: "59.94"}
+	for r, name := range names {
+		if r.String() != name {
+			t.Errorf("String() = %s, expected %s", r, name)
+		}
+	}
+}
+
+func TestTimecodeOf(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00.5Z")
+	tc, err := FPS25.TimecodeOf(ts, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tc.String() != "12:00:00:12" {
+		t.Errorf("TimecodeOf() at 25 = %s, expected 12:00:00:12", tc)
+	}
+
+	back, err := FPS25.TimestampOf(tc, ts, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.Format() != "2024-12-14T12:00:00.48Z" {
+		t.Errorf("TimestampOf(%s) = %s, expected 2024-12-14T12:00:00.48Z", tc, back.Format())
+	}
+
+	// Drop-frame timecode gains about 2.6 frames a day on the clock, so it
+	// is a frame ahead by noon.
+	tc, err = FPS2997DF.TimecodeOf(mustParse(t, "2024-12-14T12:00:00Z"), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tc.String() != "12:00:00;01" {
+		t.Errorf("TimecodeOf(noon) at 29.97 DF = %s, expected 12:00:00;01", tc)
+	}
+}
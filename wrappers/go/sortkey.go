@@ -0,0 +1,44 @@
+package universal_timestamp
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// sortableKeyLen is the length of a ToSortableKey string.
+const sortableKeyLen = 16
+
+// ToSortableKey returns a fixed-width, 16-character lowercase hex key whose
+// byte-wise order matches chronological order, including for instants
+// before 1970. It is suited to object-store and key-value key prefixes.
+func (t Timestamp) ToSortableKey() string {
+	var buf [sortableKeyLen]byte
+	b := t.SortableBytes()
+	hex.Encode(buf[:], b[:])
+	return string(buf[:])
+}
+
+// FromSortableKey parses a key produced by ToSortableKey.
+func FromSortableKey(s string) (Timestamp, error) {
+	if len(s) != sortableKeyLen {
+		return 0, ErrInvalidFormat
+	}
+	var b [8]byte
+	if _, err := hex.Decode(b[:], []byte(s)); err != nil {
+		return 0, ErrInvalidFormat
+	}
+	return FromSortableBytes(b), nil
+}
+
+// SortableBytes returns the timestamp as 8 big-endian bytes with the sign
+// bit flipped, so that byte-wise comparison matches chronological order.
+func (t Timestamp) SortableBytes() [8]byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(t)^(1<<63))
+	return b
+}
+
+// FromSortableBytes is the inverse of SortableBytes.
+func FromSortableBytes(b [8]byte) Timestamp {
+	return Timestamp(binary.BigEndian.Uint64(b[:]) ^ (1 << 63))
+}
@@ -0,0 +1,55 @@
+package universal_timestamp
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestSortableKeyOrder(t *testing.T) {
+	ordered := []Timestamp{
+		math.MinInt64,
+		mustParse(t, "1900-01-01T00:00:00Z"),
+		-1,
+		0,
+		1,
+		mustParse(t, "2024-12-14T12:00:00Z"),
+		math.MaxInt64,
+	}
+	for i, ts := range ordered {
+		key := ts.ToSortableKey()
+		if len(key) != 16 {
+			t.Errorf("ToSortableKey(%d) = %q, expected 16 characters", int64(ts), key)
+		}
+		back, err := FromSortableKey(key)
+		if err != nil || back != ts {
+			t.Errorf("FromSortableKey(%q) = %d, %v; expected %d", key, int64(back), err, int64(ts))
+		}
+		if i == 0 {
+			continue
+		}
+		prev := ordered[i-1]
+		if prev.ToSortableKey() >= key {
+			t.Errorf("key of %d does not sort before key of %d", int64(prev), int64(ts))
+		}
+		pb, b := prev.SortableBytes(), ts.SortableBytes()
+		if bytes.Compare(pb[:], b[:]) >= 0 {
+			t.Errorf("bytes of %d do not sort before bytes of %d", int64(prev), int64(ts))
+		}
+		if FromSortableBytes(b) != ts {
+			t.Errorf("FromSortableBytes() did not round-trip %d", int64(ts))
+		}
+	}
+
+	if key := Timestamp(0).ToSortableKey(); key != "8000000000000000" {
+		t.Errorf("ToSortableKey(0) = %s", key)
+	}
+}
+
+func TestFromSortableKeyErrors(t *testing.T) {
+	for _, s := range []string{"", "800000000000000", "80000000000000000", "800000000000000g"} {
+		if _, err := FromSortableKey(s); err != ErrInvalidFormat {
+			t.Errorf("FromSortableKey(%q) = %v, expected ErrInvalidFormat", s, err)
+		}
+	}
+}
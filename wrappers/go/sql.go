@@ -0,0 +1,136 @@
+package universal_timestamp
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultSQLPrecision is the number of fractional-second digits written by
+// SQLTimestamp and NullTimestamp until SetSQLPrecision is called. It
+// matches PostgreSQL timestamptz and MySQL DATETIME(6).
+const DefaultSQLPrecision = 6
+
+// sqlPrecision holds the fractional digits written to databases.
+var sqlPrecision atomic.Int32
+
+func init() {
+	sqlPrecision.Store(DefaultSQLPrecision)
+}
+
+// SetSQLPrecision sets the number of fractional-second digits (0-9) kept
+// when SQLTimestamp and NullTimestamp values are written to a database;
+// finer digits are floored. A negative value restores DefaultSQLPrecision.
+func SetSQLPrecision(digits int) {
+	if digits > 9 {
+		digits = 9
+	}
+	if digits < 0 {
+		digits = DefaultSQLPrecision
+	}
+	sqlPrecision.Store(int32(digits))
+}
+
+// SQLPrecision returns the number of fractional-second digits currently
+// written by SQLTimestamp and NullTimestamp.
+func SQLPrecision() int {
+	return int(sqlPrecision.Load())
+}
+
+// TruncateDigits floors t to the given number of fractional-second digits
+// (0-9), so instants before 1970 move away from the epoch rather than
+// towards it.
+func (t Timestamp) TruncateDigits(digits int) Timestamp {
+	scale, err := clickHouseScale(digits)
+	if err != nil {
+		return t
+	}
+	return Timestamp(floorMultiple(int64(t), scale))
+}
+
+// SQLTimestamp is a Timestamp that implements sql.Scanner and
+// driver.Valuer, so it can be used directly in database/sql and sqlx
+// structs mapped to DATETIME, timestamp or timestamptz columns. A plain
+// Timestamp is stored by drivers as its int64 nanosecond count.
+type SQLTimestamp Timestamp
+
+// Value implements driver.Valuer. The value is a UTC time.Time floored to
+// SQLPrecision digits.
+func (t SQLTimestamp) Value() (driver.Value, error) {
+	return Timestamp(t).TruncateDigits(SQLPrecision()).ToTime(), nil
+}
+
+// GormDataType reports the generic "time" type, so GORM migrations create
+// DATETIME or timestamptz columns rather than integers.
+func (SQLTimestamp) GormDataType() string {
+	return "time"
+}
+
+// Scan implements sql.Scanner for time.Time values and for strings such
+// as "2024-12-14 12:00:00.123456", which are read as UTC when they carry
+// no offset.
+func (t *SQLTimestamp) Scan(src interface{}) error {
+	if src == nil {
+		return errors.New("cannot scan NULL into SQLTimestamp")
+	}
+	ts, err := scanTimestamp(src)
+	if err != nil {
+		return err
+	}
+	*t = SQLTimestamp(ts)
+	return nil
+}
+
+// NullTimestamp is a Timestamp that may be NULL, in the style of
+// sql.NullTime.
+type NullTimestamp struct {
+	Timestamp Timestamp
+	Valid     bool
+}
+
+// Value implements driver.Valuer, returning nil when n is not valid.
+func (n NullTimestamp) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return SQLTimestamp(n.Timestamp).Value()
+}
+
+// GormDataType reports the generic "time" type, as for SQLTimestamp.
+func (NullTimestamp) GormDataType() string {
+	return "time"
+}
+
+// Scan implements sql.Scanner, setting Valid to false for NULL.
+func (n *NullTimestamp) Scan(src interface{}) error {
+	if src == nil {
+		*n = NullTimestamp{}
+		return nil
+	}
+	ts, err := scanTimestamp(src)
+	if err != nil {
+		return err
+	}
+	*n = NullTimestamp{Timestamp: ts, Valid: true}
+	return nil
+}
+
+// scanTimestamp converts a non-NULL database value to a Timestamp.
+func scanTimestamp(src interface{}) (Timestamp, error) {
+	switch v := src.(type) {
+	case time.Time:
+		if v.Before(time.Unix(0, math.MinInt64)) || v.After(time.Unix(0, math.MaxInt64)) {
+			return 0, ErrOutOfRange
+		}
+		return FromTime(v), nil
+	case string:
+		return Parse(v, AllowSpaceSeparator(), AllowNoOffset())
+	case []byte:
+		return Parse(string(v), AllowSpaceSeparator(), AllowNoOffset())
+	default:
+		return 0, fmt.Errorf("cannot scan %T into Timestamp", src)
+	}
+}
@@ -0,0 +1,105 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSQLTimestampValue(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00.123456789Z")
+	v, err := SQLTimestamp(ts).Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, ok := v.(time.Time)
+	if !ok {
+		t.Fatalf("Value() = %T, expected time.Time", v)
+	}
+	if got.Location() != time.UTC || got.Nanosecond() != 123456000 {
+		t.Errorf("Value() = %s, expected microsecond-truncated UTC", got)
+	}
+
+	SetSQLPrecision(3)
+	defer SetSQLPrecision(-1)
+	v, _ = SQLTimestamp(ts).Value()
+	if n := v.(time.Time).Nanosecond(); n != 123000000 {
+		t.Errorf("Value() nanoseconds = %d, expected 123000000", n)
+	}
+}
+
+func TestSetSQLPrecision(t *testing.T) {
+	defer SetSQLPrecision(-1)
+	SetSQLPrecision(12)
+	if got := SQLPrecision(); got != 9 {
+		t.Errorf("SQLPrecision() = %d, expected 9", got)
+	}
+	SetSQLPrecision(-1)
+	if got := SQLPrecision(); got != DefaultSQLPrecision {
+		t.Errorf("SQLPrecision() = %d, expected %d", got, DefaultSQLPrecision)
+	}
+}
+
+func TestTruncateDigits(t *testing.T) {
+	ts := mustParse(t, "1969-12-31T23:59:59.9999Z")
+	if got := ts.TruncateDigits(0); got != -Timestamp(Second) {
+		t.Errorf("TruncateDigits(0) = %s, expected 1969-12-31T23:59:59Z", got.Format())
+	}
+	if got := ts.TruncateDigits(10); got != ts {
+		t.Errorf("TruncateDigits(10) = %s, expected unchanged", got.Format())
+	}
+	if got := Timestamp(math.MinInt64).TruncateDigits(0); got != math.MinInt64/Timestamp(Second)*Timestamp(Second) {
+		t.Errorf("TruncateDigits(MinInt64) = %d, expected saturation at the earliest whole second", got)
+	}
+}
+
+func TestSQLTimestampScan(t *testing.T) {
+	want := mustParse(t, "2024-12-14T12:00:00.123456Z")
+	sources := []interface{}{
+		want.ToTime(),
+		want.In(time.FixedZone("", 3600)),
+		"2024-12-14 12:00:00.123456",
+		[]byte("2024-12-14T12:00:00.123456Z"),
+	}
+	for _, src := range sources {
+		var got SQLTimestamp
+		if err := got.Scan(src); err != nil {
+			t.Errorf("Scan(%v) error: %v", src, err)
+			continue
+		}
+		if Timestamp(got) != want {
+			t.Errorf("Scan(%v) = %s, expected %s", src, Timestamp(got).Format(), want.Format())
+		}
+	}
+
+	var ts SQLTimestamp
+	if err := ts.Scan(nil); err == nil {
+		t.Error("Scan(nil) should fail")
+	}
+	if err := ts.Scan(int64(0)); err == nil {
+		t.Error("Scan(int64) should fail")
+	}
+	if err := ts.Scan(time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Scan(3000-01-01) error = %v, expected ErrOutOfRange", err)
+	}
+}
+
+func TestNullTimestamp(t *testing.T) {
+	var n NullTimestamp
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) = %+v, %v, expected invalid", n, err)
+	}
+	if v, err := n.Value(); v != nil || err != nil {
+		t.Errorf("Value() = %v, %v, expected nil", v, err)
+	}
+
+	ts := mustParse(t, "2024-12-14T12:00:00Z")
+	if err := n.Scan(ts.ToTime()); err != nil || !n.Valid || n.Timestamp != ts {
+		t.Errorf("Scan(time) = %+v, %v", n, err)
+	}
+	v, _ := n.Value()
+	if !v.(time.Time).Equal(ts.ToTime()) {
+		t.Errorf("Value() = %v, expected %v", v, ts.ToTime())
+	}
+}
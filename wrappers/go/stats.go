@@ -0,0 +1,47 @@
+package universal_timestamp
+
+import "sort"
+
+// ArrivalStats summarizes the inter-arrival times of a set of timestamps.
+type ArrivalStats struct {
+	// Count is the number of timestamps.
+	Count int
+	// Span is the time from the earliest to the latest timestamp.
+	Span Duration
+	// Min, Max, Mean, Median and P95 describe the gaps between
+	// consecutive timestamps in chronological order. P95 uses the
+	// nearest-rank method.
+	Min, Max, Mean, Median, P95 Duration
+}
+
+// Stats computes inter-arrival statistics for ts, which need not be sorted
+// and is not modified. With fewer than two timestamps, only Count is set.
+func Stats(ts Timestamps) ArrivalStats {
+	st := ArrivalStats{Count: len(ts)}
+	if len(ts) < 2 {
+		return st
+	}
+
+	sorted := append(Timestamps(nil), ts...)
+	sorted.Sort()
+	st.Span = Duration(sorted[len(sorted)-1] - sorted[0])
+
+	gaps := make([]Duration, len(sorted)-1)
+	for i := range gaps {
+		gaps[i] = Duration(sorted[i+1] - sorted[i])
+	}
+	sort.Slice(gaps, func(i, j int) bool { return gaps[i] < gaps[j] })
+
+	n := len(gaps)
+	st.Min = gaps[0]
+	st.Max = gaps[n-1]
+	st.Mean = st.Span / Duration(n)
+	if n%2 == 1 {
+		st.Median = gaps[n/2]
+	} else {
+		lo, hi := gaps[n/2-1], gaps[n/2]
+		st.Median = lo + (hi-lo)/2
+	}
+	st.P95 = gaps[(95*n+99)/100-1]
+	return st
+}
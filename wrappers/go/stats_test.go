@@ -0,0 +1,49 @@
+package universal_timestamp
+
+import "testing"
+
+func TestStats(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	// Gaps of 1s, 2s, 3s and 10s, deliberately out of order.
+	ts := Timestamps{
+		start + Timestamp(16*Second),
+		start,
+		start + Timestamp(3*Second),
+		start + Timestamp(Second),
+		start + Timestamp(6*Second),
+	}
+	original := append(Timestamps(nil), ts...)
+
+	st := Stats(ts)
+	expected := ArrivalStats{
+		Count:  5,
+		Span:   16 * Second,
+		Min:    Second,
+		Max:    10 * Second,
+		Mean:   4 * Second,
+		Median: 2500 * Millisecond,
+		P95:    10 * Second,
+	}
+	if st != expected {
+		t.Errorf("Stats() = %+v, expected %+v", st, expected)
+	}
+	for i := range ts {
+		if ts[i] != original[i] {
+			t.Fatal("Stats() modified its input")
+		}
+	}
+}
+
+func TestStatsPercentile(t *testing.T) {
+	ts := make(Timestamps, 101)
+	for i := range ts {
+		ts[i] = Timestamp(i*i) * Timestamp(Millisecond)
+	}
+	// Gaps are 1ms, 3ms, ..., 199ms; the 95th of 100 is 189ms.
+	if p95 := Stats(ts).P95; p95 != 189*Millisecond {
+		t.Errorf("P95 = %s, expected PT0.189S", p95)
+	}
+	if st := Stats(Timestamps{mustParse(t, "2024-12-14T12:00:00Z")}); st != (ArrivalStats{Count: 1}) {
+		t.Errorf("Stats() of one timestamp = %+v", st)
+	}
+}
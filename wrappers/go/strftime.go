@@ -0,0 +1,131 @@
+package universal_timestamp
+
+import (
+	"strconv"
+	"time"
+)
+
+// Strftime formats the timestamp in loc using C strftime-style directives.
+// A nil loc is treated as UTC. Supported directives:
+//
+//	%Y  year                     %G  ISO 8601 week-based year
+//	%y  year without century     %g  ISO week-based year without century
+//	%m  month (01-12)            %V  ISO 8601 week number (01-53)
+//	%d  day of month (01-31)     %u  ISO weekday (1-7, Monday is 1)
+//	%e  day of month, space-padded
+//	%j  day of year (001-366)    %w  weekday (0-6, Sunday is 0)
+//	%H  hour (00-23)             %I  hour (01-12)
+//	%M  minute (00-59)           %S  second (00-59)
+//	%p  AM or PM                 %N  nanoseconds (000000000-999999999)
+//	%a  abbreviated weekday      %A  full weekday name
+//	%b  abbreviated month        %B  full month name
+//	%z  offset as +hhmm          %Z  zone abbreviation
+//	%s  seconds since the epoch  %%  a literal '%'
+//
+// %G and %V differ from %Y and the calendar week around January 1: for
+// example 2024-12-30 is "2025-W01". Unknown directives are copied to the
+// output unchanged.
+func (t Timestamp) Strftime(format string, loc *time.Location) string {
+	lt := t.In(loc)
+	b := make([]byte, 0, len(format)+16)
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c != '%' || i+1 == len(format) {
+			b = append(b, c)
+			continue
+		}
+		i++
+		b = appendStrftimeDirective(b, format[i], lt, t)
+	}
+	return string(b)
+}
+
+func appendStrftimeDirective(b []byte, d byte, lt time.Time, t Timestamp) []byte {
+	switch d {
+	case 'Y':
+		return strconv.AppendInt(b, int64(lt.Year()), 10)
+	case 'y':
+		return appendPadded(b, lt.Year()%100, 2)
+	case 'G':
+		year, _ := lt.ISOWeek()
+		return strconv.AppendInt(b, int64(year), 10)
+	case 'g':
+		year, _ := lt.ISOWeek()
+		return appendPadded(b, year%100, 2)
+	case 'V':
+		_, week := lt.ISOWeek()
+		return appendPadded(b, week, 2)
+	case 'm':
+		return appendPadded(b, int(lt.Month()), 2)
+	case 'd':
+		return appendPadded(b, lt.Day(), 2)
+	case 'e':
+		if lt.Day() < 10 {
+			b = append(b, ' ')
+		}
+		return strconv.AppendInt(b, int64(lt.Day()), 10)
+	case 'j':
+		return appendPadded(b, lt.YearDay(), 3)
+	case 'u':
+		wd := int(lt.Weekday())
+		if wd == 0 {
+			wd = 7
+		}
+		return strconv.AppendInt(b, int64(wd), 10)
+	case 'w':
+		return strconv.AppendInt(b, int64(lt.Weekday()), 10)
+	case 'H':
+		return appendPadded(b, lt.Hour(), 2)
+	case 'I':
+		h := lt.Hour() % 12
+		if h == 0 {
+			h = 12
+		}
+		return appendPadded(b, h, 2)
+	case 'M':
+		return appendPadded(b, lt.Minute(), 2)
+	case 'S':
+		return appendPadded(b, lt.Second(), 2)
+	case 'N':
+		return appendPadded(b, lt.Nanosecond(), 9)
+	case 'p':
+		if lt.Hour() < 12 {
+			return append(b, "AM"...)
+		}
+		return append(b, "PM"...)
+	case 'a':
+		return append(b, lt.Weekday().String()[:3]...)
+	case 'A':
+		return append(b, lt.Weekday().String()...)
+	case 'b':
+		return append(b, lt.Month().String()[:3]...)
+	case 'B':
+		return append(b, lt.Month().String()...)
+	case 'z':
+		return lt.AppendFormat(b, "-0700")
+	case 'Z':
+		name, _ := lt.Zone()
+		return append(b, name...)
+	case 's':
+		return strconv.AppendInt(b, floorDiv(int64(t), int64(Second)), 10)
+	case '%':
+		return append(b, '%')
+	}
+	return append(b, '%', d)
+}
+
+// appendPadded appends n zero-padded to at least width digits.
+func appendPadded(b []byte, n, width int) []byte {
+	if n < 0 {
+		b = append(b, '-')
+		n = -n
+	}
+	var buf [20]byte
+	i := len(buf)
+	for n > 0 || i > len(buf)-width {
+		i--
+		buf[i] = byte('0' + n%10)
+		n /= 10
+	}
+	return append(b, buf[i:]...)
+}
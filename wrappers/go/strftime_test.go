@@ -0,0 +1,49 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStrftime(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T09:05:03.000000042Z")
+	cases := map[string]string{
+		"%Y-%m-%dT%H:%M:%S.%NZ": "2024-12-14T09:05:03.000000042Z",
+		"%a %b %e %I:%M %p":     "Sat Dec 14 09:05 AM",
+		"%A %B %d %Y":           "Saturday December 14 2024",
+		"%j %u %w %y":           "349 6 6 24",
+		"%G-W%V-%u":             "2024-W50-6",
+		"%s %z %Z %%":           "1734167103 +0000 UTC %",
+		"100%":                  "100%",
+		"%Q":                    "%Q",
+	}
+	for format, expected := range cases {
+		if got := ts.Strftime(format, nil); got != expected {
+			t.Errorf("Strftime(%q) = %q, expected %q", format, got, expected)
+		}
+	}
+}
+
+func TestStrftimeISOWeekBoundaries(t *testing.T) {
+	cases := map[string]string{
+		"2024-12-30T00:00:00Z": "2025-W01-1 25 2024",
+		"2021-01-03T00:00:00Z": "2020-W53-7 20 2021",
+		"2026-01-01T00:00:00Z": "2026-W01-4 26 2026",
+	}
+	for input, expected := range cases {
+		if got := mustParse(t, input).Strftime("%G-W%V-%u %g %Y", nil); got != expected {
+			t.Errorf("Strftime(%s) = %q, expected %q", input, got, expected)
+		}
+	}
+}
+
+func TestStrftimeLocation(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	ts := mustParse(t, "2024-12-14T20:00:00Z")
+	if got := ts.Strftime("%Y-%m-%d %H:%M %z %Z", tokyo); got != "2024-12-15 05:00 +0900 JST" {
+		t.Errorf("Strftime(Tokyo) = %q", got)
+	}
+}
@@ -0,0 +1,150 @@
+package universal_timestamp
+
+import "fmt"
+
+// Sub-nanosecond units, in attoseconds.
+const (
+	AttosPerPicosecond = 1_000_000
+	AttosPerNanosecond = 1_000_000_000
+)
+
+// PrecisionTimestamp is an instant with attosecond resolution, for
+// PTP/White Rabbit hardware timestamps and physics instrumentation where
+// nanoseconds are too coarse. It has the same range as Timestamp.
+type PrecisionTimestamp struct {
+	// Nanos is the instant floored to the nanosecond.
+	Nanos Timestamp
+	// Attos is the part of the instant beyond Nanos, in attoseconds
+	// (0-999999999).
+	Attos int32
+}
+
+// NewPrecisionTimestamp returns the instant attos attoseconds after nanos.
+// attos may be negative or exceed one nanosecond; it is carried into Nanos.
+func NewPrecisionTimestamp(nanos Timestamp, attos int64) PrecisionTimestamp {
+	carry := floorDiv(attos, AttosPerNanosecond)
+	return PrecisionTimestamp{
+		Nanos: nanos + Timestamp(carry),
+		Attos: int32(attos - carry*AttosPerNanosecond),
+	}
+}
+
+// Timestamp returns p floored to the nanosecond.
+func (p PrecisionTimestamp) Timestamp() Timestamp {
+	return p.Nanos
+}
+
+// Picos returns the picoseconds (0-999) beyond Nanos, discarding finer
+// digits.
+func (p PrecisionTimestamp) Picos() int {
+	return int(p.Attos / AttosPerPicosecond)
+}
+
+// Add returns p+d.
+func (p PrecisionTimestamp) Add(d Duration) PrecisionTimestamp {
+	return PrecisionTimestamp{Nanos: p.Nanos + Timestamp(d), Attos: p.Attos}
+}
+
+// AddAttos returns the instant n attoseconds after p.
+func (p PrecisionTimestamp) AddAttos(n int64) PrecisionTimestamp {
+	return NewPrecisionTimestamp(p.Nanos, int64(p.Attos)+n)
+}
+
+// Sub returns p-u as whole nanoseconds plus an attosecond remainder
+// (0-999999999), so a negative difference of half a nanosecond is -1ns
+// plus 500000000 attoseconds.
+func (p PrecisionTimestamp) Sub(u PrecisionTimestamp) (Duration, int64) {
+	d := NewPrecisionTimestamp(p.Nanos-u.Nanos, int64(p.Attos)-int64(u.Attos))
+	return Duration(d.Nanos), int64(d.Attos)
+}
+
+// Compare returns -1, 0 or +1 as p is before, equal to or after u.
+func (p PrecisionTimestamp) Compare(u PrecisionTimestamp) int {
+	switch {
+	case p.Nanos < u.Nanos || p.Nanos == u.Nanos && p.Attos < u.Attos:
+		return -1
+	case p == u:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Before reports whether p is earlier than u.
+func (p PrecisionTimestamp) Before(u PrecisionTimestamp) bool {
+	return p.Compare(u) < 0
+}
+
+// After reports whether p is later than u.
+func (p PrecisionTimestamp) After(u PrecisionTimestamp) bool {
+	return p.Compare(u) > 0
+}
+
+// Format formats p as an ISO-8601 string with up to 18 fractional-second
+// digits, omitting trailing zeros. Without a sub-nanosecond part it matches
+// Timestamp.Format in the canonical configuration.
+func (p PrecisionTimestamp) Format() string {
+	return string(p.appendFormat(nil))
+}
+
+// appendFormat appends the Format rendering of p to dst.
+func (p PrecisionTimestamp) appendFormat(dst []byte) []byte {
+	if p.Attos == 0 {
+		return appendFormatC(dst, p.Nanos, true)
+	}
+	b := appendFixedPrecision(dst, p.Nanos, 9)
+	b = b[:len(b)-1]
+	for attos, i := int64(p.Attos), 0; i < 9 && attos != 0; i++ {
+		b = append(b, byte('0'+attos/(AttosPerNanosecond/10)))
+		attos = attos % (AttosPerNanosecond / 10) * 10
+	}
+	return append(b, 'Z')
+}
+
+// String returns p.Format().
+func (p PrecisionTimestamp) String() string {
+	return p.Format()
+}
+
+// ParsePrecisionTimestamp parses an ISO-8601 string with up to 18
+// fractional-second digits. opts are applied as for Parse, except that
+// fractions longer than 18 digits always return ErrFractionTooLong.
+func ParsePrecisionTimestamp(s string, opts ...ParseOption) (PrecisionTimestamp, error) {
+	var attos int64
+	if len(s) > 19 && s[19] == '.' {
+		end := 20
+		for end < len(s) && isDigit(s[end]) {
+			end++
+		}
+		if end-20 > 18 {
+			return PrecisionTimestamp{}, fmt.Errorf("%w: %q", ErrFractionTooLong, s)
+		}
+		for i := 29; i < 38; i++ {
+			attos *= 10
+			if i < end {
+				attos += int64(s[i] - '0')
+			}
+		}
+	}
+
+	ts, err := Parse(s, append(opts, MaxFractionDigits(18))...)
+	if err != nil {
+		return PrecisionTimestamp{}, err
+	}
+	return PrecisionTimestamp{Nanos: ts, Attos: int32(attos)}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PrecisionTimestamp) MarshalText() ([]byte, error) {
+	return p.appendFormat(nil), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PrecisionTimestamp) UnmarshalText(text []byte) error {
+	parsed, err := ParsePrecisionTimestamp(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
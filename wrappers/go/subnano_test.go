@@ -0,0 +1,92 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPrecisionTimestampFormat(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00.123456789Z")
+	cases := []struct {
+		attos    int64
+		expected string
+	}{
+		{0, "2024-12-14T12:00:00.123456789Z"},
+		{500_000_000, "2024-12-14T12:00:00.1234567895Z"},
+		{123 * AttosPerPicosecond, "2024-12-14T12:00:00.123456789123Z"},
+		{1, "2024-12-14T12:00:00.123456789000000001Z"},
+		{AttosPerNanosecond, "2024-12-14T12:00:00.12345679Z"},
+	}
+	for _, c := range cases {
+		p := NewPrecisionTimestamp(ts, c.attos)
+		if got := p.Format(); got != c.expected {
+			t.Errorf("Format(%d attos) = %s, expected %s", c.attos, got, c.expected)
+		}
+		back, err := ParsePrecisionTimestamp(c.expected)
+		if err != nil {
+			t.Fatalf("ParsePrecisionTimestamp(%q) error: %v", c.expected, err)
+		}
+		if back != p {
+			t.Errorf("ParsePrecisionTimestamp(%q) = %+v, expected %+v", c.expected, back, p)
+		}
+	}
+}
+
+func TestParsePrecisionTimestamp(t *testing.T) {
+	p, err := ParsePrecisionTimestamp("1970-01-01T00:00:00.000000001002Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Nanos != 1 || p.Attos != 2*AttosPerPicosecond || p.Picos() != 2 {
+		t.Errorf("ParsePrecisionTimestamp() = %+v, expected 1ns and 2ps", p)
+	}
+
+	_, err = ParsePrecisionTimestamp("1970-01-01T00:00:00.1234567890123456789Z")
+	if !errors.Is(err, ErrFractionTooLong) {
+		t.Errorf("19 fractional digits: error = %v, expected ErrFractionTooLong", err)
+	}
+	_, err = ParsePrecisionTimestamp("not a timestamp")
+	if !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("invalid input: error = %v, expected ErrInvalidFormat", err)
+	}
+}
+
+func TestPrecisionTimestampArithmetic(t *testing.T) {
+	p := NewPrecisionTimestamp(1000, -250_000_000)
+	if p.Nanos != 999 || p.Attos != 750_000_000 {
+		t.Errorf("NewPrecisionTimestamp(1000, -0.25ns) = %+v, expected 999ns and 750000000 attos", p)
+	}
+
+	q := p.AddAttos(500_000_000)
+	if q.Nanos != 1000 || q.Attos != 250_000_000 {
+		t.Errorf("AddAttos() = %+v, expected 1000ns and 250000000 attos", q)
+	}
+	if d, attos := p.Sub(q); d != -1 || attos != 500_000_000 {
+		t.Errorf("Sub() = %d, %d, expected -1, 500000000", d, attos)
+	}
+	if d, attos := q.Add(Microsecond).Sub(p); d != Microsecond || attos != 500_000_000 {
+		t.Errorf("Add().Sub() = %d, %d, expected 1000, 500000000", d, attos)
+	}
+
+	if !p.Before(q) || !q.After(p) || p.Compare(p) != 0 {
+		t.Errorf("Compare(%+v, %+v) is inconsistent", p, q)
+	}
+	if q.Timestamp() != 1000 {
+		t.Errorf("Timestamp() = %d, expected 1000", q.Timestamp())
+	}
+}
+
+func TestPrecisionTimestampText(t *testing.T) {
+	p := NewPrecisionTimestamp(mustParse(t, "2024-12-14T12:00:00Z"), 42)
+	text, err := p.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back PrecisionTimestamp
+	if err := back.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if back != p {
+		t.Errorf("UnmarshalText(%s) = %+v, expected %+v", text, back, p)
+	}
+}
@@ -0,0 +1,78 @@
+package universal_timestamp
+
+import (
+	"fmt"
+	"time"
+)
+
+// Layouts of the syslog TIMESTAMP field.
+const (
+	// rfc3164Layout is the BSD syslog form, with the day padded by a space.
+	rfc3164Layout = "Jan _2 15:04:05"
+	// rfc5424Layout limits the fraction to the six digits RFC 5424 allows.
+	rfc5424Layout = "2006-01-02T15:04:05.999999Z07:00"
+)
+
+// ParseRFC3164 parses a BSD syslog timestamp such as "Dec 14 12:00:00" or
+// "Dec  4 12:00:00". The form carries neither a year nor a zone: the
+// wall-clock time is read in loc, the sender's zone, and the year is the
+// one that puts the result closest to ref, usually the time the message
+// was received, so December messages read in January fall in the previous
+// year. A nil loc is treated as UTC. It returns ErrInvalidFormat for other
+// input.
+func ParseRFC3164(s string, ref Timestamp, loc *time.Location) (Timestamp, error) {
+	t, err := time.Parse(rfc3164Layout, s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var best Timestamp
+	found := false
+	refYear := ref.In(loc).Year()
+	for year := refYear - 1; year <= refYear+1; year++ {
+		if t.Day() > DaysInMonth(year, t.Month()) {
+			continue
+		}
+		ts := FromTime(time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc))
+		if !found || ts.Sub(ref).Abs() < best.Sub(ref).Abs() {
+			best, found = ts, true
+		}
+	}
+	if !found {
+		return 0, fmt.Errorf("%w: no year near %d has %q", ErrOutOfRange, refYear, s)
+	}
+	return best, nil
+}
+
+// FormatRFC3164 formats ts as a BSD syslog timestamp, such as
+// "Dec  4 12:00:00", in loc. Fractional seconds are dropped. A nil loc is
+// treated as UTC.
+func FormatRFC3164(ts Timestamp, loc *time.Location) string {
+	return ts.In(loc).Format(rfc3164Layout)
+}
+
+// ParseRFC5424 parses an RFC 5424 syslog timestamp such as
+// "2024-12-14T12:00:00.123456+01:00", keeping its offset. RFC 5424 allows
+// at most six fractional digits and requires an offset or "Z". The
+// NILVALUE "-", sent when the originator has no clock, is reported as
+// ErrInvalidFormat like any other malformed input.
+func ParseRFC5424(s string) (ZonedTimestamp, error) {
+	if s == "-" {
+		return ZonedTimestamp{}, fmt.Errorf("%w: syslog NILVALUE", ErrInvalidFormat)
+	}
+	ts, offset, err := ParseWithOffset(s, MaxFractionDigits(6))
+	if err != nil {
+		return ZonedTimestamp{}, err
+	}
+	return ZonedTimestamp{Instant: ts, Location: offsetZone(offset)}, nil
+}
+
+// FormatRFC5424 formats z as an RFC 5424 syslog timestamp in its location,
+// such as "2024-12-14T13:00:00.123456+01:00". Fractional seconds are
+// truncated to microseconds and trailing zeros are omitted.
+func FormatRFC5424(z ZonedTimestamp) string {
+	return z.Time().Format(rfc5424Layout)
+}
@@ -0,0 +1,91 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseRFC3164(t *testing.T) {
+	cases := []struct {
+		in       string
+		ref      string
+		expected string
+	}{
+		{"Dec 14 12:00:00", "2024-12-14T12:05:00Z", "2024-12-14T12:00:00Z"},
+		{"Dec  4 12:00:00", "2024-12-14T12:05:00Z", "2024-12-04T12:00:00Z"},
+		// December messages read in January belong to the previous year.
+		{"Dec 31 23:59:59", "2025-01-01T00:00:05Z", "2024-12-31T23:59:59Z"},
+		// A sender whose clock runs ahead across the new year.
+		{"Jan  1 00:00:02", "2024-12-31T23:59:59Z", "2025-01-01T00:00:02Z"},
+		// February 29 only exists in leap years.
+		{"Feb 29 08:00:00", "2025-03-01T00:00:00Z", "2024-02-29T08:00:00Z"},
+	}
+	for _, c := range cases {
+		got, err := ParseRFC3164(c.in, mustParse(t, c.ref), nil)
+		if err != nil {
+			t.Fatalf("ParseRFC3164(%q) error: %v", c.in, err)
+		}
+		if got.Format() != c.expected {
+			t.Errorf("ParseRFC3164(%q, %s) = %s, expected %s", c.in, c.ref, got.Format(), c.expected)
+		}
+	}
+
+	for _, s := range []string{"", "Dec 14 12:00", "2024-12-14T12:00:00Z", "Foo 14 12:00:00"} {
+		if _, err := ParseRFC3164(s, 0, nil); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseRFC3164(%q) error = %v, expected ErrInvalidFormat", s, err)
+		}
+	}
+	if _, err := ParseRFC3164("Feb 29 08:00:00", mustParse(t, "2022-06-01T00:00:00Z"), nil); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("ParseRFC3164(Feb 29 in 2021-2023) error = %v, expected ErrOutOfRange", err)
+	}
+}
+
+func TestRFC3164Zone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	ref := mustParse(t, "2024-12-14T18:00:00Z")
+	got, err := ParseRFC3164("Dec 14 12:00:00", ref, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Format() != "2024-12-14T17:00:00Z" {
+		t.Errorf("ParseRFC3164(New_York) = %s, expected 2024-12-14T17:00:00Z", got.Format())
+	}
+	if s := FormatRFC3164(got, loc); s != "Dec 14 12:00:00" {
+		t.Errorf("FormatRFC3164(New_York) = %q, expected %q", s, "Dec 14 12:00:00")
+	}
+	if s := FormatRFC3164(mustParse(t, "2024-12-04T12:00:00.5Z"), nil); s != "Dec  4 12:00:00" {
+		t.Errorf("FormatRFC3164() = %q, expected %q", s, "Dec  4 12:00:00")
+	}
+}
+
+func TestRFC5424(t *testing.T) {
+	z, err := ParseRFC5424("2024-12-14T13:00:00.123456+01:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z.Instant.Format() != "2024-12-14T12:00:00.123456Z" || z.Offset() != 3600 {
+		t.Errorf("ParseRFC5424() = %s, expected 2024-12-14T13:00:00.123456+01:00", z)
+	}
+	if got := FormatRFC5424(z); got != "2024-12-14T13:00:00.123456+01:00" {
+		t.Errorf("FormatRFC5424() = %s, expected 2024-12-14T13:00:00.123456+01:00", got)
+	}
+
+	utc := NewZoned(mustParse(t, "2024-12-14T12:00:00.1234567Z"), nil)
+	if got := FormatRFC5424(utc); got != "2024-12-14T12:00:00.123456Z" {
+		t.Errorf("FormatRFC5424(UTC) = %s, expected 2024-12-14T12:00:00.123456Z", got)
+	}
+
+	if _, err := ParseRFC5424("2024-12-14T12:00:00.1234567Z"); !errors.Is(err, ErrFractionTooLong) {
+		t.Errorf("ParseRFC5424(7 digits) error = %v, expected ErrFractionTooLong", err)
+	}
+	for _, s := range []string{"-", "2024-12-14 12:00:00Z"} {
+		if _, err := ParseRFC5424(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseRFC5424(%q) error = %v, expected ErrInvalidFormat", s, err)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package universal_timestamp
+
+import (
+	"fmt"
+	"time"
+)
+
+// TemplateFuncs returns functions for text/template and html/template that
+// render timestamps directly, so handlers need not pre-format every field.
+// The map is untyped and can be passed to either package's Funcs:
+//
+//	format    {{ .At | format }}                   canonical ISO-8601
+//	strftime  {{ .At | strftime "%d %b %Y" }}      Strftime layout
+//	inZone    {{ .At | inZone "Europe/Paris" }}    ZonedTimestamp in a zone
+//	add       {{ .At | add "PT1H" }}               shift by an ISO duration
+//	humanize  {{ .Took | humanize }}               "1h 32m"; "3h ago" for instants
+//
+// Each function accepts a Timestamp, ZonedTimestamp or time.Time, and
+// strftime, add and humanize keep the zone of a ZonedTimestamp. Invalid
+// arguments and unknown zones stop template execution with an error.
+// humanize reads the current time from DefaultClock when it runs.
+func TemplateFuncs() map[string]interface{} {
+	return templateFuncs(nil)
+}
+
+// templateFuncs builds the TemplateFuncs map with humanize relative to
+// clock. A nil clock uses DefaultClock.
+func templateFuncs(clock Clock) map[string]interface{} {
+	return map[string]interface{}{
+		"format": func(v interface{}) (string, error) {
+			ts, loc, err := templateInstant(v)
+			if err != nil {
+				return "", err
+			}
+			if loc != nil {
+				return NewZoned(ts, loc).Format(), nil
+			}
+			return ts.Format(), nil
+		},
+		"strftime": func(layout string, v interface{}) (string, error) {
+			ts, loc, err := templateInstant(v)
+			if err != nil {
+				return "", err
+			}
+			return ts.Strftime(layout, loc), nil
+		},
+		"inZone": func(name string, v interface{}) (ZonedTimestamp, error) {
+			ts, _, err := templateInstant(v)
+			if err != nil {
+				return ZonedTimestamp{}, err
+			}
+			loc, err := time.LoadLocation(name)
+			if err != nil {
+				return ZonedTimestamp{}, fmt.Errorf("%w: %s", ErrUnknownZone, name)
+			}
+			return NewZoned(ts, loc), nil
+		},
+		"add": func(d interface{}, v interface{}) (interface{}, error) {
+			ts, loc, err := templateInstant(v)
+			if err != nil {
+				return nil, err
+			}
+			var period Period
+			var dur Duration
+			switch d := d.(type) {
+			case Duration:
+				dur = d
+			case time.Duration:
+				dur = FromStd(d)
+			case string:
+				if period, dur, err = ParseISODuration(d); err != nil {
+					return nil, err
+				}
+			default:
+				return nil, fmt.Errorf("add: unsupported duration %T", d)
+			}
+			ts = ts.AddPeriod(period, loc) + Timestamp(dur)
+			if loc != nil {
+				return NewZoned(ts, loc), nil
+			}
+			return ts, nil
+		},
+		"humanize": func(v interface{}) (string, error) {
+			switch d := v.(type) {
+			case Duration:
+				return d.Humanize(HumanizeComponents(2)), nil
+			case time.Duration:
+				return FromStd(d).Humanize(HumanizeComponents(2)), nil
+			}
+			ts, _, err := templateInstant(v)
+			if err != nil {
+				return "", err
+			}
+			d := clockOrSystem(clock).Until(ts)
+			switch {
+			case d < 0:
+				return (-d).Humanize(HumanizeComponents(1)) + " ago", nil
+			case d > 0:
+				return "in " + d.Humanize(HumanizeComponents(1)), nil
+			}
+			return "now", nil
+		},
+	}
+}
+
+// templateInstant extracts the instant and, for zoned values, the location
+// from a template argument.
+func templateInstant(v interface{}) (Timestamp, *time.Location, error) {
+	switch v := v.(type) {
+	case Timestamp:
+		return v, nil, nil
+	case ZonedTimestamp:
+		loc := v.Location
+		if loc == nil {
+			loc = time.UTC
+		}
+		return v.Instant, loc, nil
+	case time.Time:
+		return FromTime(v), v.Location(), nil
+	}
+	return 0, nil, fmt.Errorf("unsupported timestamp type %T", v)
+}
@@ -0,0 +1,70 @@
+package universal_timestamp
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	now := mustParse(t, "2024-12-14T12:00:00Z")
+	data := map[string]interface{}{
+		"At":    now - Timestamp(3*Hour),
+		"Took":  92 * Minute,
+		"Fixed": NewZoned(now, time.FixedZone("", 3600)),
+	}
+	tests := map[string]string{
+		`{{ .At | format }}`:                    "2024-12-14T09:00:00Z",
+		`{{ .At | strftime "%d %b %Y %H:%M" }}`: "14 Dec 2024 09:00",
+		`{{ .At | add "PT1H30M" | format }}`:    "2024-12-14T10:30:00Z",
+		`{{ .At | add "P1D" | format }}`:        "2024-12-15T09:00:00Z",
+		`{{ .At | add "-P1D" | format }}`:       "2024-12-13T09:00:00Z",
+		`{{ .Fixed | add "PT1H" }}`:             "2024-12-14T14:00:00+01:00",
+		`{{ .Fixed | strftime "%H:%M %z" }}`:    "13:00 +0100",
+		`{{ .Took | humanize }}`:                "1h 32m",
+		`{{ .At | humanize }}`:                  "3h ago",
+		`{{ .At | add "PT4H" | humanize }}`:     "in 1h",
+	}
+	funcs := templateFuncs(NewManualClock(now))
+	for src, expected := range tests {
+		tmpl := template.Must(template.New("").Funcs(funcs).Parse(src))
+		var b strings.Builder
+		if err := tmpl.Execute(&b, data); err != nil {
+			t.Errorf("%s: %v", src, err)
+			continue
+		}
+		if b.String() != expected {
+			t.Errorf("%s = %q, expected %q", src, b.String(), expected)
+		}
+	}
+}
+
+func TestTemplateFuncsInZone(t *testing.T) {
+	if _, err := time.LoadLocation("Europe/Paris"); err != nil {
+		t.Skip("tzdata not available")
+	}
+	tmpl := htmltemplate.Must(htmltemplate.New("").Funcs(TemplateFuncs()).Parse(`{{ .At | inZone "Europe/Paris" }}`))
+	var b strings.Builder
+	if err := tmpl.Execute(&b, map[string]Timestamp{"At": mustParse(t, "2024-12-14T12:00:00Z")}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "2024-12-14T13:00:00&#43;01:00[Europe/Paris]"; b.String() != expected {
+		t.Errorf("inZone = %q, expected %q", b.String(), expected)
+	}
+}
+
+func TestTemplateFuncsErrors(t *testing.T) {
+	for _, src := range []string{
+		`{{ .At | inZone "Nowhere/City" }}`,
+		`{{ .At | add "P1X" }}`,
+		`{{ "x" | format }}`,
+	} {
+		tmpl := template.Must(template.New("").Funcs(TemplateFuncs()).Parse(src))
+		var b strings.Builder
+		if err := tmpl.Execute(&b, map[string]Timestamp{"At": 0}); err == nil {
+			t.Errorf("%s should fail", src)
+		}
+	}
+}
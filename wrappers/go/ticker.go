@@ -0,0 +1,80 @@
+package universal_timestamp
+
+import "sync"
+
+// Ticker delivers timestamps on wall-clock-aligned boundaries. With a
+// ManualClock each tick is sent during the Set or Advance call that
+// reaches its boundary.
+type Ticker struct {
+	// C receives the boundary each tick was scheduled for. Ticks are
+	// dropped, as with time.Ticker, if the receiver falls behind.
+	C <-chan Timestamp
+
+	mu            sync.Mutex
+	c             chan Timestamp
+	clock         Clock
+	every, offset Duration
+	cancel        func() bool
+	stopped       bool
+	stopOnce      sync.Once
+}
+
+// AlignedTicker returns a Ticker that fires on every boundary at which
+// (ts - offset) is a multiple of every, for example on each minute at :00
+// for AlignedTicker(Minute, 0, nil). The wait for each tick is recomputed
+// from clock, so the ticker does not drift. A nil clock uses DefaultClock.
+// AlignedTicker panics if every is not positive.
+func AlignedTicker(every, offset Duration, clock Clock) *Ticker {
+	if every <= 0 {
+		panic("universal_timestamp: non-positive interval for AlignedTicker")
+	}
+	c := make(chan Timestamp, 1)
+	t := &Ticker{C: c, c: c, clock: clockOrSystem(clock), every: every, offset: offset}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.scheduleLocked(nextAlignedBoundary(t.clock.Now(), every, offset))
+	return t
+}
+
+// Stop turns off the ticker. It does not close C. Stop may be called more
+// than once, including concurrently.
+func (t *Ticker) Stop() {
+	t.stopOnce.Do(func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.stopped = true
+		if t.cancel != nil {
+			t.cancel()
+			t.cancel = nil
+		}
+	})
+}
+
+// scheduleLocked arranges for a tick at boundary next.
+func (t *Ticker) scheduleLocked(next Timestamp) {
+	t.cancel = clockAfterFunc(t.clock, next, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if t.stopped {
+			return
+		}
+		select {
+		case t.c <- next:
+		default:
+		}
+		// A real timer may fire a little before the clock reads next;
+		// never schedule the same boundary twice.
+		now := t.clock.Now()
+		if now < next {
+			now = next
+		}
+		t.scheduleLocked(nextAlignedBoundary(now, t.every, t.offset))
+	})
+}
+
+// nextAlignedBoundary returns the first boundary strictly after ts.
+func nextAlignedBoundary(ts Timestamp, every, offset Duration) Timestamp {
+	n := floorDiv(int64(ts)-int64(offset), int64(every))
+	return Timestamp((n+1)*int64(every) + int64(offset))
+}
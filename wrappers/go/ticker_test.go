@@ -0,0 +1,106 @@
+package universal_timestamp
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNextAlignedBoundary(t *testing.T) {
+	cases := []struct {
+		now, expected string
+		every, offset Duration
+	}{
+		{"2024-12-14T12:00:10Z", "2024-12-14T12:01:00Z", Minute, 0},
+		{"2024-12-14T12:01:00Z", "2024-12-14T12:02:00Z", Minute, 0},
+		{"2024-12-14T12:00:10Z", "2024-12-14T12:00:15Z", Minute, 15 * Second},
+		{"2024-12-14T12:20:00Z", "2024-12-14T13:05:00Z", Hour, 5 * Minute},
+		{"1969-12-31T23:59:30Z", "1970-01-01T00:00:00Z", Minute, 0},
+	}
+	for _, c := range cases {
+		got := nextAlignedBoundary(mustParse(t, c.now), c.every, c.offset)
+		if got.Format() != c.expected {
+			t.Errorf("nextAlignedBoundary(%s) = %s, expected %s", c.now, got.Format(), c.expected)
+		}
+	}
+}
+
+func TestAlignedTicker(t *testing.T) {
+	clock := NewManualClock(mustParse(t, "2024-12-14T12:00:10Z"))
+	ticker := AlignedTicker(Minute, 0, clock)
+	defer ticker.Stop()
+
+	expectTick := func(expected string) {
+		t.Helper()
+		select {
+		case ts := <-ticker.C:
+			if ts.Format() != expected {
+				t.Errorf("tick = %s, expected %s", ts.Format(), expected)
+			}
+		default:
+			t.Errorf("no tick, expected %s", expected)
+		}
+	}
+
+	clock.Advance(49 * Second)
+	select {
+	case ts := <-ticker.C:
+		t.Fatalf("tick %s before the boundary", ts.Format())
+	default:
+	}
+	clock.Advance(Second)
+	expectTick("2024-12-14T12:01:00Z")
+	clock.Advance(Minute)
+	expectTick("2024-12-14T12:02:00Z")
+
+	// A jump past several boundaries delivers one tick and realigns.
+	clock.Advance(5*Minute + 30*Second)
+	expectTick("2024-12-14T12:03:00Z")
+	clock.Advance(30 * Second)
+	expectTick("2024-12-14T12:08:00Z")
+}
+
+func TestAlignedTickerSystemClock(t *testing.T) {
+	every := 20 * Millisecond
+	ticker := AlignedTicker(every, 0, nil)
+	defer ticker.Stop()
+
+	var prev Timestamp
+	for i := 0; i < 3; i++ {
+		select {
+		case ts := <-ticker.C:
+			if int64(ts)%int64(every) != 0 {
+				t.Errorf("tick %s is not aligned to %s", ts.Format(), every)
+			}
+			if prev != 0 && ts <= prev {
+				t.Errorf("tick %d did not advance", i)
+			}
+			prev = ts
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for tick")
+		}
+	}
+}
+
+func TestAlignedTickerStop(t *testing.T) {
+	clock := NewManualClock(0)
+	ticker := AlignedTicker(10*Millisecond, 0, clock)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ticker.Stop()
+		}()
+	}
+	wg.Wait()
+	ticker.Stop()
+
+	clock.Advance(Second)
+	select {
+	case ts := <-ticker.C:
+		t.Errorf("received tick %s after Stop", ts.Format())
+	default:
+	}
+}
@@ -0,0 +1,96 @@
+package universal_timestamp
+
+import "sync"
+
+// Timer delivers a single Timestamp on C once its clock reaches a target
+// instant. With a ManualClock the value is sent during the Set or Advance
+// call that reaches the target, so tests can drive schedulers in virtual
+// time.
+type Timer struct {
+	// C receives the target instant when the timer fires.
+	C <-chan Timestamp
+
+	mu     sync.Mutex
+	c      chan Timestamp
+	clock  Clock
+	target Timestamp
+	cancel func() bool
+	gen    uint64
+}
+
+// NewTimer returns a Timer that fires at the given instant, immediately if
+// it has already passed. A nil clock uses DefaultClock.
+func NewTimer(clock Clock, at Timestamp) *Timer {
+	c := make(chan Timestamp, 1)
+	t := &Timer{C: c, c: c, clock: clockOrSystem(clock)}
+	t.Reset(at)
+	return t
+}
+
+// NewCountdown returns a Timer that fires d after the clock's current time.
+// A nil clock uses DefaultClock.
+func NewCountdown(clock Clock, d Duration) *Timer {
+	clock = clockOrSystem(clock)
+	return NewTimer(clock, clock.Now()+Timestamp(d))
+}
+
+// Reset reschedules the timer to fire at the given instant, discarding any
+// value already delivered but not yet received. It reports whether the
+// timer was still pending.
+func (t *Timer) Reset(at Timestamp) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	pending := t.stopLocked()
+	t.target = at
+	t.gen++
+	gen := t.gen
+	t.cancel = clockAfterFunc(t.clock, at, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if t.cancel == nil || t.gen != gen {
+			return
+		}
+		t.cancel = nil
+		select {
+		case t.c <- at:
+		default:
+		}
+	})
+	return pending
+}
+
+// Stop prevents the timer from firing and discards any value already
+// delivered but not yet received. It reports whether the timer was still
+// pending.
+func (t *Timer) Stop() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stopLocked()
+}
+
+// Target returns the instant the timer is, or was last, scheduled for.
+func (t *Timer) Target() Timestamp {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.target
+}
+
+// Remaining returns the time left until the target according to the
+// timer's clock. The result is negative once the target has passed.
+func (t *Timer) Remaining() Duration {
+	return t.clock.Until(t.Target())
+}
+
+func (t *Timer) stopLocked() bool {
+	pending := t.cancel != nil
+	if pending {
+		t.cancel()
+		t.cancel = nil
+	}
+	select {
+	case <-t.c:
+	default:
+	}
+	return pending
+}
@@ -0,0 +1,90 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimerManualClock(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	clock := NewManualClock(start)
+	timer := NewCountdown(clock, Minute)
+
+	clock.Advance(30 * Second)
+	select {
+	case <-timer.C:
+		t.Fatal("timer fired early")
+	default:
+	}
+	if got := timer.Remaining(); got != 30*Second {
+		t.Errorf("Remaining() = %s, expected PT30S", got)
+	}
+
+	clock.Advance(30 * Second)
+	select {
+	case ts := <-timer.C:
+		if ts != start+Timestamp(Minute) {
+			t.Errorf("timer delivered %s, expected target", ts.Format())
+		}
+	default:
+		t.Fatal("timer did not fire when the clock reached its target")
+	}
+	if timer.Stop() {
+		t.Error("Stop() after firing = true, expected false")
+	}
+}
+
+func TestTimerReset(t *testing.T) {
+	start := mustParse(t, "2024-12-14T12:00:00Z")
+	clock := NewManualClock(start)
+	timer := NewTimer(clock, start+Timestamp(Second))
+
+	if !timer.Reset(start + Timestamp(Hour)) {
+		t.Error("Reset() on pending timer = false, expected true")
+	}
+	clock.Advance(Minute)
+	select {
+	case <-timer.C:
+		t.Fatal("timer fired at its old target")
+	default:
+	}
+
+	clock.Advance(Hour)
+	timer.Reset(clock.Now() + Timestamp(Second))
+	select {
+	case <-timer.C:
+		t.Fatal("Reset() should discard the undelivered value")
+	default:
+	}
+	clock.Advance(Second)
+	if ts := <-timer.C; ts != timer.Target() {
+		t.Errorf("timer delivered %s, expected %s", ts.Format(), timer.Target().Format())
+	}
+
+	timer.Reset(clock.Now() + Timestamp(Second))
+	if !timer.Stop() {
+		t.Error("Stop() on pending timer = false, expected true")
+	}
+	clock.Advance(Hour)
+	select {
+	case <-timer.C:
+		t.Error("stopped timer fired")
+	default:
+	}
+}
+
+func TestTimerSystemClock(t *testing.T) {
+	timer := NewCountdown(nil, 10*Millisecond)
+	select {
+	case <-timer.C:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for timer")
+	}
+
+	past := NewTimer(nil, 0)
+	select {
+	case <-past.C:
+	case <-time.After(time.Second):
+		t.Fatal("timer with past target did not fire")
+	}
+}
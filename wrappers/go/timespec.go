@@ -0,0 +1,39 @@
+//go:build unix
+
+package universal_timestamp
+
+import (
+	"math"
+	"syscall"
+)
+
+// FromTimespec converts a kernel timespec, such as one returned by stat or
+// clock_gettime, to a Timestamp.
+func FromTimespec(ts syscall.Timespec) Timestamp {
+	return Timestamp(ts.Nano())
+}
+
+// ToTimespec converts t to a kernel timespec.
+func ToTimespec(t Timestamp) syscall.Timespec {
+	return syscall.NsecToTimespec(int64(t))
+}
+
+// FromTimeval converts a kernel timeval, such as a packet capture header
+// time, to a Timestamp.
+func FromTimeval(tv syscall.Timeval) Timestamp {
+	return Timestamp(tv.Nano())
+}
+
+// ToTimeval converts t to a kernel timeval, truncating toward the earlier
+// microsecond.
+func ToTimeval(t Timestamp) syscall.Timeval {
+	// NsecToTimeval adds 999ns before dividing, which rounds positive
+	// values up and mishandles negative ones; cancel it out on an exact
+	// microsecond so only the floor below applies. The earliest
+	// microseconds have no room for that, so they saturate one later.
+	ns := floorMultiple(int64(t), 1000)
+	if ns < math.MinInt64+999 {
+		ns += 1000
+	}
+	return syscall.NsecToTimeval(ns - 999)
+}
@@ -0,0 +1,33 @@
+//go:build unix
+
+package universal_timestamp
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTimespecRoundTrip(t *testing.T) {
+	for _, s := range []string{"2024-12-14T12:00:00.123456789Z", "1969-12-31T23:59:59.5Z", "1970-01-01T00:00:00Z"} {
+		ts := mustParse(t, s)
+		if got := FromTimespec(ToTimespec(ts)); got != ts {
+			t.Errorf("Timespec round trip of %s = %s", s, got.Format())
+		}
+	}
+}
+
+func TestTimevalTruncates(t *testing.T) {
+	cases := map[string]string{
+		"2024-12-14T12:00:00.123456789Z": "2024-12-14T12:00:00.123456Z",
+		"1969-12-31T23:59:59.999999999Z": "1969-12-31T23:59:59.999999Z",
+		"1970-01-01T00:00:00Z":           "1970-01-01T00:00:00Z",
+	}
+	for input, expected := range cases {
+		if got := FromTimeval(ToTimeval(mustParse(t, input))).Format(); got != expected {
+			t.Errorf("Timeval round trip of %s = %s, expected %s", input, got, expected)
+		}
+	}
+	if got := FromTimeval(ToTimeval(math.MinInt64)); got != math.MinInt64/1000*1000+1000 {
+		t.Errorf("Timeval round trip of MinInt64 = %d, expected the earliest usable microsecond", got)
+	}
+}
@@ -0,0 +1,21 @@
+package universal_timestamp
+
+import "sort"
+
+// Timestamps is a slice of timestamps. It implements sort.Interface in
+// chronological order.
+type Timestamps []Timestamp
+
+func (ts Timestamps) Len() int           { return len(ts) }
+func (ts Timestamps) Less(i, j int) bool { return ts[i] < ts[j] }
+func (ts Timestamps) Swap(i, j int)      { ts[i], ts[j] = ts[j], ts[i] }
+
+// Sort sorts ts in place in chronological order.
+func (ts Timestamps) Sort() {
+	sort.Sort(ts)
+}
+
+// IsSorted reports whether ts is in chronological order.
+func (ts Timestamps) IsSorted() bool {
+	return sort.IsSorted(ts)
+}
@@ -0,0 +1,14 @@
+package universal_timestamp
+
+import "testing"
+
+func TestTimestampsSort(t *testing.T) {
+	ts := Timestamps{3, -1, 2, 0}
+	if ts.IsSorted() {
+		t.Error("IsSorted() = true for unsorted input")
+	}
+	ts.Sort()
+	if !ts.IsSorted() || ts[0] != -1 || ts[3] != 3 {
+		t.Errorf("Sort() = %v", ts)
+	}
+}
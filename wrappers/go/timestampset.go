@@ -0,0 +1,156 @@
+package universal_timestamp
+
+import "sort"
+
+// TimestampSet is a sorted set of distinct instants, such as the times of
+// stored snapshots, supporting range and nearest-neighbor lookups. The
+// zero value is an empty set.
+type TimestampSet struct {
+	ts []Timestamp
+}
+
+// NewTimestampSet returns the set of the given instants.
+func NewTimestampSet(ts ...Timestamp) *TimestampSet {
+	sorted := append(Timestamps(nil), ts...)
+	sorted.Sort()
+	n := 0
+	for i, t := range sorted {
+		if i == 0 || t != sorted[n-1] {
+			sorted[n] = t
+			n++
+		}
+	}
+	return &TimestampSet{ts: sorted[:n]}
+}
+
+// search returns the index of the first instant at or after ts.
+func (s *TimestampSet) search(ts Timestamp) int {
+	return sort.Search(len(s.ts), func(i int) bool { return s.ts[i] >= ts })
+}
+
+// Add inserts ts, reporting whether it was not already in the set.
+func (s *TimestampSet) Add(ts Timestamp) bool {
+	i := s.search(ts)
+	if i < len(s.ts) && s.ts[i] == ts {
+		return false
+	}
+	s.ts = append(s.ts, 0)
+	copy(s.ts[i+1:], s.ts[i:])
+	s.ts[i] = ts
+	return true
+}
+
+// Remove deletes ts, reporting whether it was in the set.
+func (s *TimestampSet) Remove(ts Timestamp) bool {
+	i := s.search(ts)
+	if i == len(s.ts) || s.ts[i] != ts {
+		return false
+	}
+	s.ts = append(s.ts[:i], s.ts[i+1:]...)
+	return true
+}
+
+// Contains reports whether ts is in the set.
+func (s *TimestampSet) Contains(ts Timestamp) bool {
+	i := s.search(ts)
+	return i < len(s.ts) && s.ts[i] == ts
+}
+
+// Len returns the number of instants in the set.
+func (s *TimestampSet) Len() int {
+	return len(s.ts)
+}
+
+// RangeQuery returns the instants that fall within iv, in order.
+func (s *TimestampSet) RangeQuery(iv Interval) []Timestamp {
+	if iv.IsEmpty() {
+		return nil
+	}
+	lo, hi := s.search(iv.Start), s.search(iv.End)
+	if lo == hi {
+		return nil
+	}
+	return append([]Timestamp(nil), s.ts[lo:hi]...)
+}
+
+// Before returns the latest instant strictly before ts: the closest
+// snapshot preceding ts. ok is false if there is none.
+func (s *TimestampSet) Before(ts Timestamp) (prev Timestamp, ok bool) {
+	i := s.search(ts)
+	if i == 0 {
+		return 0, false
+	}
+	return s.ts[i-1], true
+}
+
+// Floor returns the latest instant at or before ts. ok is false if there
+// is none.
+func (s *TimestampSet) Floor(ts Timestamp) (floor Timestamp, ok bool) {
+	i := s.search(ts)
+	if i < len(s.ts) && s.ts[i] == ts {
+		return ts, true
+	}
+	return s.Before(ts)
+}
+
+// After returns the earliest instant strictly after ts. ok is false if
+// there is none.
+func (s *TimestampSet) After(ts Timestamp) (next Timestamp, ok bool) {
+	i := s.search(ts)
+	if i < len(s.ts) && s.ts[i] == ts {
+		i++
+	}
+	if i == len(s.ts) {
+		return 0, false
+	}
+	return s.ts[i], true
+}
+
+// Ceil returns the earliest instant at or after ts. ok is false if there
+// is none.
+func (s *TimestampSet) Ceil(ts Timestamp) (ceil Timestamp, ok bool) {
+	i := s.search(ts)
+	if i == len(s.ts) {
+		return 0, false
+	}
+	return s.ts[i], true
+}
+
+// Nearest returns the instant closest to ts on either side, preferring the
+// earlier of two equally close. ok is false if the set is empty.
+func (s *TimestampSet) Nearest(ts Timestamp) (nearest Timestamp, ok bool) {
+	floor, hasFloor := s.Floor(ts)
+	ceil, hasCeil := s.Ceil(ts)
+	switch {
+	case !hasCeil:
+		return floor, hasFloor
+	case !hasFloor:
+		return ceil, true
+	case uint64(ceil-ts) < uint64(ts-floor):
+		return ceil, true
+	}
+	return floor, true
+}
+
+// Min returns the earliest instant in the set. ok is false if the set is
+// empty.
+func (s *TimestampSet) Min() (min Timestamp, ok bool) {
+	if len(s.ts) == 0 {
+		return 0, false
+	}
+	return s.ts[0], true
+}
+
+// Max returns the latest instant in the set. ok is false if the set is
+// empty.
+func (s *TimestampSet) Max() (max Timestamp, ok bool) {
+	if len(s.ts) == 0 {
+		return 0, false
+	}
+	return s.ts[len(s.ts)-1], true
+}
+
+// Timestamps returns a copy of the set's instants in order.
+func (s *TimestampSet) Timestamps() Timestamps {
+	return append(Timestamps(nil), s.ts...)
+}
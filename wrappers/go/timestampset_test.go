@@ -0,0 +1,92 @@
+package universal_timestamp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTimestampSetAddRemove(t *testing.T) {
+	s := NewTimestampSet(30, 10, 20, 10)
+	if !s.Add(15) || s.Add(20) || !s.Add(0) {
+		t.Errorf("Add() reported the wrong membership")
+	}
+	if !s.Remove(30) || s.Remove(25) {
+		t.Errorf("Remove() reported the wrong membership")
+	}
+
+	expected := Timestamps{0, 10, 15, 20}
+	if got := s.Timestamps(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Timestamps() = %v, expected %v", got, expected)
+	}
+	if s.Len() != 4 || !s.Contains(15) || s.Contains(30) {
+		t.Errorf("Len() = %d, Contains(15) = %v, Contains(30) = %v", s.Len(), s.Contains(15), s.Contains(30))
+	}
+
+	var empty TimestampSet
+	if _, ok := empty.Min(); ok || empty.Len() != 0 || empty.Remove(0) {
+		t.Errorf("zero TimestampSet is not empty")
+	}
+}
+
+func TestTimestampSetRangeQuery(t *testing.T) {
+	s := NewTimestampSet(10, 20, 30, 40)
+	cases := []struct {
+		iv       Interval
+		expected []Timestamp
+	}{
+		{span(10, 30), []Timestamp{10, 20}},
+		{span(11, 41), []Timestamp{20, 30, 40}},
+		{span(0, 10), nil},
+		{span(30, 20), nil},
+	}
+	for _, c := range cases {
+		if got := s.RangeQuery(c.iv); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("RangeQuery(%v) = %v, expected %v", c.iv, got, c.expected)
+		}
+	}
+}
+
+func TestTimestampSetNeighbors(t *testing.T) {
+	s := NewTimestampSet(10, 20, 30)
+
+	type lookup func(Timestamp) (Timestamp, bool)
+	cases := []struct {
+		name     string
+		fn       lookup
+		ts       Timestamp
+		expected Timestamp
+		ok       bool
+	}{
+		{"Before", s.Before, 20, 10, true},
+		{"Before", s.Before, 25, 20, true},
+		{"Before", s.Before, 10, 0, false},
+		{"Floor", s.Floor, 20, 20, true},
+		{"Floor", s.Floor, 9, 0, false},
+		{"After", s.After, 20, 30, true},
+		{"After", s.After, 30, 0, false},
+		{"Ceil", s.Ceil, 20, 20, true},
+		{"Ceil", s.Ceil, 21, 30, true},
+		{"Ceil", s.Ceil, 31, 0, false},
+		{"Nearest", s.Nearest, 14, 10, true},
+		{"Nearest", s.Nearest, 15, 10, true},
+		{"Nearest", s.Nearest, 16, 20, true},
+		{"Nearest", s.Nearest, -100, 10, true},
+		{"Nearest", s.Nearest, 100, 30, true},
+	}
+	for _, c := range cases {
+		got, ok := c.fn(c.ts)
+		if got != c.expected || ok != c.ok {
+			t.Errorf("%s(%d) = %d, %v; expected %d, %v", c.name, c.ts, got, ok, c.expected, c.ok)
+		}
+	}
+
+	if min, _ := s.Min(); min != 10 {
+		t.Errorf("Min() = %d, expected 10", min)
+	}
+	if max, _ := s.Max(); max != 30 {
+		t.Errorf("Max() = %d, expected 30", max)
+	}
+	if _, ok := NewTimestampSet().Nearest(0); ok {
+		t.Errorf("Nearest() on an empty set succeeded")
+	}
+}
@@ -0,0 +1,83 @@
+package universal_timestamp
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// TrueTime reports the current time as an interval bounded by the clock's
+// worst-case error, in the style of Spanner's TrueTime. The error bound can
+// be updated at any time, for example by an NTP or PTP estimator, and is
+// safe for concurrent use.
+type TrueTime struct {
+	clock Clock
+	bound atomic.Int64
+}
+
+// NewTrueTime returns a TrueTime reading clock with the given error bound.
+// A nil clock uses DefaultClock, looked up on every reading so that a clock
+// installed later by Init takes effect.
+func NewTrueTime(clock Clock, bound Duration) *TrueTime {
+	tt := &TrueTime{clock: clock}
+	tt.SetErrorBound(bound)
+	return tt
+}
+
+// SetErrorBound sets the maximum error of the clock. Negative values are
+// treated as zero.
+func (tt *TrueTime) SetErrorBound(d Duration) {
+	if d < 0 {
+		d = 0
+	}
+	tt.bound.Store(int64(d))
+}
+
+// ErrorBound returns the current maximum clock error.
+func (tt *TrueTime) ErrorBound() Duration {
+	return Duration(tt.bound.Load())
+}
+
+// NowInterval returns an interval that contains the true current time:
+// Start is the earliest and End the latest it can be, inclusive.
+func (tt *TrueTime) NowInterval() Interval {
+	now := clockOrSystem(tt.clock).Now()
+	b := Timestamp(tt.ErrorBound())
+	return Interval{Start: now - b, End: now + b}
+}
+
+// After reports whether ts has definitely passed.
+func (tt *TrueTime) After(ts Timestamp) bool {
+	return tt.NowInterval().Start > ts
+}
+
+// Before reports whether ts has definitely not arrived yet.
+func (tt *TrueTime) Before(ts Timestamp) bool {
+	return tt.NowInterval().End < ts
+}
+
+// WaitUntilAfter blocks until ts has definitely passed or ctx is done,
+// which implements commit wait: a transaction stamped ts may release its
+// locks once WaitUntilAfter returns nil. It waits with SleepUntilClock, so
+// a ManualClock must be advanced by another goroutine.
+func (tt *TrueTime) WaitUntilAfter(ctx context.Context, ts Timestamp) error {
+	for !tt.After(ts) {
+		if err := SleepUntilClock(ctx, tt.clock, ts+Timestamp(tt.ErrorBound())+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// defaultTrueTime backs the package-level NowInterval.
+var defaultTrueTime = NewTrueTime(nil, 0)
+
+// NowInterval returns the current time as an interval bounded by the error
+// set with SetClockErrorBound, which is zero by default.
+func NowInterval() Interval {
+	return defaultTrueTime.NowInterval()
+}
+
+// SetClockErrorBound sets the error bound used by NowInterval.
+func SetClockErrorBound(d Duration) {
+	defaultTrueTime.SetErrorBound(d)
+}
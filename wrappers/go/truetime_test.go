@@ -0,0 +1,53 @@
+package universal_timestamp
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTrueTime(t *testing.T) {
+	now := mustParse(t, "2024-12-14T12:00:00Z")
+	tt := NewTrueTime(NewManualClock(now), 5*Millisecond)
+
+	iv := tt.NowInterval()
+	if iv.Start != now-Timestamp(5*Millisecond) || iv.End != now+Timestamp(5*Millisecond) {
+		t.Errorf("NowInterval() = %s", iv)
+	}
+	if tt.After(now-Timestamp(5*Millisecond)) || !tt.After(now-Timestamp(6*Millisecond)) {
+		t.Error("After() results wrong")
+	}
+	if tt.Before(now+Timestamp(5*Millisecond)) || !tt.Before(now+Timestamp(6*Millisecond)) {
+		t.Error("Before() results wrong")
+	}
+
+	tt.SetErrorBound(-1)
+	if tt.ErrorBound() != 0 || tt.NowInterval().Duration() != 0 {
+		t.Errorf("negative bound not clamped: %s", tt.ErrorBound())
+	}
+}
+
+func TestTrueTimeWaitUntilAfter(t *testing.T) {
+	tt := NewTrueTime(nil, 10*Millisecond)
+	ts := Now()
+	if err := tt.WaitUntilAfter(context.Background(), ts); err != nil {
+		t.Fatalf("WaitUntilAfter() = %v", err)
+	}
+	if !tt.After(ts) {
+		t.Error("WaitUntilAfter() returned before ts had definitely passed")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if err := tt.WaitUntilAfter(ctx, Now()+Timestamp(Hour)); err != context.DeadlineExceeded {
+		t.Errorf("WaitUntilAfter() with expiring context = %v", err)
+	}
+}
+
+func TestNowInterval(t *testing.T) {
+	defer SetClockErrorBound(0)
+	SetClockErrorBound(Millisecond)
+	if d := NowInterval().Duration(); d != 2*Millisecond {
+		t.Errorf("NowInterval().Duration() = %s, expected PT0.002S", d)
+	}
+}
@@ -9,7 +9,9 @@ package universal_timestamp
 import "C"
 
 import (
-	"errors"
+	"fmt"
+	"math"
+	"strconv"
 	"time"
 	"unsafe"
 )
@@ -20,33 +22,261 @@ type Timestamp int64
 
 // Now returns the current UTC timestamp.
 func Now() Timestamp {
+	observeCgo("ut_now")
 	return Timestamp(C.ut_now().nanos)
 }
 
 // NowNanos returns the current UTC timestamp as Unix nanoseconds (int64).
 func NowNanos() int64 {
+	observeCgo("ut_now")
 	return int64(C.ut_now().nanos)
 }
 
 // Parse parses an ISO-8601 string into a timestamp.
-// It uses strict strict parsing by default.
-func Parse(s string) (Timestamp, error) {
+// It uses strict parsing unless lenient parsing was enabled with SetDefaults;
+// opts relax individual rules for this call only. Every failure wraps
+// ErrInvalidFormat; too many fractional digits also wrap ErrFractionTooLong
+// and out-of-range fields such as hour 24 also wrap ErrOutOfRange.
+func Parse(s string, opts ...ParseOption) (Timestamp, error) {
+	return defaultCodec().Parse(s, opts...)
+}
+
+// ParseWithOffset parses an ISO-8601 string that may carry a numeric UTC
+// offset such as "+05:30", returning the instant together with the
+// sender's offset in seconds east of UTC so it can be preserved.
+func ParseWithOffset(s string, opts ...ParseOption) (Timestamp, int, error) {
+	return defaultCodec().ParseWithOffset(s, opts...)
+}
+
+// Format formats the timestamp as an ISO-8601 string, using the precision
+// and offset style configured with SetDefaults. Every Timestamp can be
+// formatted: should the C core fail, the same text is produced in Go.
+// FormatChecked reports such a failure instead.
+func (t Timestamp) Format() string {
+	return defaultCodec().Format(t)
+}
+
+// FormatChecked is like Format but returns ErrBufferTooSmall, rather than
+// falling back to Go, if the C core cannot render the value.
+func (t Timestamp) FormatChecked() (string, error) {
+	return defaultCodec().FormatChecked(t)
+}
+
+// FormatLen returns the length of the string Format would return.
+func (t Timestamp) FormatLen() int {
+	return defaultCodec().FormatLen(t)
+}
+
+// AppendFormat appends the ISO-8601 form of the timestamp, as produced by
+// Format, to dst and returns the extended buffer.
+func (t Timestamp) AppendFormat(dst []byte) []byte {
+	return defaultCodec().AppendFormat(dst, t)
+}
+
+// AppendFormatChecked is like AppendFormat but returns ErrBufferTooSmall,
+// and dst unchanged, if the C core cannot render the value.
+func (t Timestamp) AppendFormatChecked(dst []byte) ([]byte, error) {
+	return defaultCodec().AppendFormatChecked(dst, t)
+}
+
+// parseC parses s with the C core in strict or lenient mode.
+func parseC(s string, strict bool) (Timestamp, error) {
+	ts, err := parseCCode(s, strict)
+	if err != C.UT_OK {
+		observeParse(cErrorReason(err))
+		return 0, cError(err)
+	}
+	observeParse("")
+	return ts, nil
+}
+
+// parseCCode parses s with the C core and returns its raw error code.
+func parseCCode(s string, strict bool) (Timestamp, C.ut_error_t) {
 	cs := C.CString(s)
 	defer C.free(unsafe.Pointer(cs))
 
 	var ts C.ut_timestamp_t
-	if err := C.ut_parse_strict(cs, &ts); err != C.UT_OK {
-		return 0, errors.New("invalid timestamp format")
+	var err C.ut_error_t
+	if strict {
+		observeCgo("ut_parse_strict")
+		err = C.ut_parse_strict(cs, &ts)
+	} else {
+		observeCgo("ut_parse_lenient")
+		err = C.ut_parse_lenient(cs, &ts)
 	}
-	return Timestamp(ts.nanos), nil
+	return Timestamp(ts.nanos), err
 }
 
-// Format formats the timestamp as an ISO-8601 string.
-func (t Timestamp) Format() string {
-	var buf [64]C.char
+// cErrorNames holds the C names of the core's error codes.
+var cErrorNames = [...]string{
+	C.UT_OK:                     "UT_OK",
+	C.UT_ERR_INVALID_FORMAT:     "UT_ERR_INVALID_FORMAT",
+	C.UT_ERR_INVALID_DATE:       "UT_ERR_INVALID_DATE",
+	C.UT_ERR_OUT_OF_RANGE:       "UT_ERR_OUT_OF_RANGE",
+	C.UT_ERR_UNSUPPORTED_OFFSET: "UT_ERR_UNSUPPORTED_OFFSET",
+	C.UT_ERR_FRACTION_TOO_LONG:  "UT_ERR_FRACTION_TOO_LONG",
+	C.UT_ERR_LEAP_SECOND:        "UT_ERR_LEAP_SECOND",
+	C.UT_ERR_NULL_POINTER:       "UT_ERR_NULL_POINTER",
+	C.UT_ERR_UNSUPPORTED_CLOCK:  "UT_ERR_UNSUPPORTED_CLOCK",
+}
+
+// coreParseCode returns the C name of the error code, such as
+// "UT_ERR_INVALID_DATE", that the C core's strict parser reports for s, or
+// "UT_OK". Tests use it to check the shared vectors against the core, since
+// Parse folds several codes into one sentinel.
+func coreParseCode(s string) string {
+	_, err := parseCCode(s, true)
+	if int(err) < len(cErrorNames) {
+		return cErrorNames[err]
+	}
+	return "ut_error_t(" + strconv.Itoa(int(err)) + ")"
+}
+
+// Parse errors for C core codes that have a more specific sentinel. They
+// also wrap ErrInvalidFormat, which every parse failure matches.
+var (
+	errCFractionTooLong = fmt.Errorf("%w: %w", ErrInvalidFormat, ErrFractionTooLong)
+	errCOutOfRange      = fmt.Errorf("%w: %w", ErrInvalidFormat, ErrOutOfRange)
+)
+
+// cError maps a C core parse error code to the error returned by Parse.
+func cError(err C.ut_error_t) error {
+	switch err {
+	case C.UT_ERR_FRACTION_TOO_LONG:
+		return errCFractionTooLong
+	case C.UT_ERR_OUT_OF_RANGE:
+		return errCOutOfRange
+	}
+	return ErrInvalidFormat
+}
+
+// cErrorReason maps a C core error code to a Metrics parse failure reason.
+func cErrorReason(err C.ut_error_t) string {
+	switch err {
+	case C.UT_ERR_INVALID_DATE:
+		return ReasonInvalidDate
+	case C.UT_ERR_OUT_OF_RANGE:
+		return ReasonOutOfRange
+	case C.UT_ERR_UNSUPPORTED_OFFSET:
+		return ReasonUnsupportedOffset
+	case C.UT_ERR_FRACTION_TOO_LONG:
+		return ReasonFractionTooLong
+	case C.UT_ERR_LEAP_SECOND:
+		return ReasonLeapSecond
+	}
+	return ReasonInvalidFormat
+}
+
+// appendFormatC appends the C core's ISO-8601 rendering of t to dst. The
+// longest rendering of an int64 timestamp, 30 bytes, fits the C buffer; if
+// the C core ever reports a truncated or failed rendering, the same text
+// is produced in Go rather than emitting partial output.
+func appendFormatC(dst []byte, t Timestamp, includeNanos bool) []byte {
+	b, _ := appendFormatCMode(dst, t, includeNanos, false)
+	return b
+}
+
+// appendFormatCMode is appendFormatC, except that in strict mode a failed
+// rendering returns dst and ErrBufferTooSmall instead of the Go fallback.
+func appendFormatCMode(dst []byte, t Timestamp, includeNanos, strict bool) ([]byte, error) {
+	var buf [C.UT_MAX_STRING_LEN]C.char
+	observeCgo("ut_format")
+	cts := C.ut_timestamp_t{nanos: C.long(t)}
+	n := C.ut_format(cts, &buf[0], C.UT_MAX_STRING_LEN, C.bool(includeNanos))
+	if n <= 0 || n >= C.UT_MAX_STRING_LEN {
+		if strict {
+			return dst, ErrBufferTooSmall
+		}
+		return appendFormatGo(dst, t, includeNanos), nil
+	}
+	return append(dst, unsafe.Slice((*byte)(unsafe.Pointer(&buf[0])), int(n))...), nil
+}
+
+// appendFormatGo renders t as the C core's ut_format does.
+func appendFormatGo(dst []byte, t Timestamp, includeNanos bool) []byte {
+	if includeNanos {
+		return t.ToTime().AppendFormat(dst, "2006-01-02T15:04:05.999999999Z")
+	}
+	return t.ToTime().AppendFormat(dst, "2006-01-02T15:04:05Z")
+}
+
+// formatLenC returns the length of the C core's ISO-8601 rendering of t.
+// Where appendFormatC would fall back to Go, so does the length, keeping
+// FormatLen equal to len(Format).
+func formatLenC(t Timestamp, includeNanos bool) int {
+	observeCgo("ut_format_len")
 	cts := C.ut_timestamp_t{nanos: C.long(t)}
-	C.ut_format(cts, &buf[0], 64, true) // strict/z
-	return C.GoString(&buf[0])
+	n := int(C.ut_format_len(cts, C.bool(includeNanos)))
+	if n <= 0 || n >= C.UT_MAX_STRING_LEN {
+		return formatLenGo(t, includeNanos)
+	}
+	return n
+}
+
+// formatLenGo returns the length of appendFormatGo's rendering of t.
+func formatLenGo(t Timestamp, includeNanos bool) int {
+	var buf [C.UT_MAX_STRING_LEN]byte
+	return len(appendFormatGo(buf[:0], t, includeNanos))
+}
+
+// FormatPrecision formats the timestamp as an ISO-8601 string with exactly
+// digits fractional-second digits (0-9). Extra precision is reduced with
+// the Rounding mode configured with SetDefaults, which truncates by
+// default. Values outside 0-9 are clamped.
+func (t Timestamp) FormatPrecision(digits int) string {
+	if digits < 0 {
+		digits = 0
+	} else if digits > 9 {
+		digits = 9
+	}
+
+	t = roundDigits(t, digits, Defaults().Rounding)
+	return string(appendFixedPrecision(nil, t, digits))
+}
+
+// appendFixedPrecision appends t to dst with exactly digits (0-9)
+// fractional-second digits and a trailing Z.
+func appendFixedPrecision(dst []byte, t Timestamp, digits int) []byte {
+	b, _ := appendFixedPrecisionMode(dst, t, digits, false)
+	return b
+}
+
+// appendFixedPrecisionMode is appendFixedPrecision with the strict mode of
+// appendFormatCMode.
+func appendFixedPrecisionMode(dst []byte, t Timestamp, digits int, strict bool) ([]byte, error) {
+	frac := int64(t) % int64(Second)
+	if frac < 0 {
+		frac += int64(Second)
+	}
+	var err error
+	if int64(t) < math.MinInt64+frac {
+		// The whole second is before the Timestamp range.
+		dst = time.Unix(floorDiv(int64(t), int64(Second)), 0).UTC().AppendFormat(dst, "2006-01-02T15:04:05Z")
+	} else {
+		dst, err = appendFormatCMode(dst, t-Timestamp(frac), false, strict)
+	}
+	if err != nil || digits == 0 {
+		return dst, err
+	}
+
+	var fraction [9]byte
+	for i := 8; i >= 0; i-- {
+		fraction[i] = byte('0' + frac%10)
+		frac /= 10
+	}
+	dst = append(dst[:len(dst)-1], '.')
+	dst = append(dst, fraction[:digits]...)
+	return append(dst, 'Z'), nil
+}
+
+// Add returns the timestamp t+d.
+func (t Timestamp) Add(d time.Duration) Timestamp {
+	return t + Timestamp(d)
+}
+
+// Sub returns the duration t-u as a time.Duration.
+func (t Timestamp) Sub(u Timestamp) time.Duration {
+	return time.Duration(t - u)
 }
 
 // ToTime converts the timestamp to a standard Go time.Time.
@@ -54,6 +284,15 @@ func (t Timestamp) ToTime() time.Time {
 	return time.Unix(0, int64(t)).UTC()
 }
 
+// In converts the timestamp to a time.Time in the given location.
+// A nil loc is treated as UTC.
+func (t Timestamp) In(loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Unix(0, int64(t)).In(loc)
+}
+
 // FromTime creates a Timestamp from a standard Go time.Time.
 func FromTime(t time.Time) Timestamp {
 	return Timestamp(t.UnixNano())
@@ -1,10 +1,20 @@
 package universal_timestamp
 
 import (
+	"math"
 	"testing"
 	"time"
 )
 
+func mustParse(t *testing.T, s string) Timestamp {
+	t.Helper()
+	ts, err := Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q) failed: %v", s, err)
+	}
+	return ts
+}
+
 func TestNow(t *testing.T) {
 	ts := Now()
 	if ts == 0 {
@@ -34,3 +44,68 @@ func TestToTime(t *testing.T) {
 		t.Errorf("Time conversion failed. Expected %v, got %v", now, goTime)
 	}
 }
+
+func TestFormatPrecision(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00.123456789Z")
+
+	cases := map[int]string{
+		0: "2024-12-14T12:00:00Z",
+		3: "2024-12-14T12:00:00.123Z",
+		9: "2024-12-14T12:00:00.123456789Z",
+	}
+	for digits, expected := range cases {
+		if got := ts.FormatPrecision(digits); got != expected {
+			t.Errorf("FormatPrecision(%d) = %s, expected %s", digits, got, expected)
+		}
+	}
+
+	if got := Timestamp(-1).FormatPrecision(3); got != "1969-12-31T23:59:59.999Z" {
+		t.Errorf("FormatPrecision(3) before epoch = %s", got)
+	}
+}
+
+func TestAppendFormat(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00.5Z")
+	buf := ts.AppendFormat([]byte("at="))
+	if string(buf) != "at=2024-12-14T12:00:00.5Z" {
+		t.Errorf("AppendFormat() = %s", buf)
+	}
+}
+
+func TestAddSub(t *testing.T) {
+	ts := mustParse(t, "2024-12-14T12:00:00Z")
+	later := ts.Add(90 * time.Minute)
+	if got := later.Format(); got != "2024-12-14T13:30:00Z" {
+		t.Errorf("Add() = %s, expected 2024-12-14T13:30:00Z", got)
+	}
+	if got := later.Sub(ts); got != 90*time.Minute {
+		t.Errorf("Sub() = %v, expected 1h30m", got)
+	}
+	if got := ts.Sub(later); got != -90*time.Minute {
+		t.Errorf("Sub() = %v, expected -1h30m", got)
+	}
+}
+
+func TestFormatExtremes(t *testing.T) {
+	for _, ts := range []Timestamp{math.MinInt64, -1, 0, mustParse(t, "2024-12-14T12:00:00.5Z"), math.MaxInt64} {
+		s := ts.Format()
+		if expected := string(appendFormatGo(nil, ts, true)); s != expected {
+			t.Errorf("Format(%d) = %s, expected %s", int64(ts), s, expected)
+		}
+		if b := ts.AppendFormat([]byte("at ")); string(b) != "at "+s {
+			t.Errorf("AppendFormat(%d) = %q", int64(ts), b)
+		}
+		if got, err := ts.FormatChecked(); err != nil || got != s {
+			t.Errorf("FormatChecked(%d) = %s, %v, expected %s", int64(ts), got, err, s)
+		}
+		if b, err := ts.AppendFormatChecked([]byte("at ")); err != nil || string(b) != "at "+s {
+			t.Errorf("AppendFormatChecked(%d) = %q, %v", int64(ts), b, err)
+		}
+		if got := ts.FormatPrecision(0); got != ts.ToTime().Truncate(time.Second).UTC().Format("2006-01-02T15:04:05Z") {
+			t.Errorf("FormatPrecision(%d, 0) = %s", int64(ts), got)
+		}
+		if n := ts.FormatLen(); n != len(s) || n != formatLenGo(ts, true) {
+			t.Errorf("FormatLen(%d) = %d, expected %d", int64(ts), n, len(s))
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Package utarrow converts between universal_timestamp values and Apache
+// Arrow timestamp arrays, so columns can be handed to Arrow and Parquet
+// writers without per-element conversion in caller code.
+package utarrow
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	uts "github.com/mozrin/universal_timestamp"
+)
+
+// ErrOverflow is returned when an Arrow value cannot be represented as
+// int64 nanoseconds.
+var ErrOverflow = errors.New("arrow timestamp out of range")
+
+// DataType returns the Arrow timestamp type for unit with tz recorded as
+// the time zone metadata. An empty tz produces a zone-naive type.
+func DataType(unit arrow.TimeUnit, tz string) *arrow.TimestampType {
+	return &arrow.TimestampType{Unit: unit, TimeZone: tz}
+}
+
+// ToArrow builds an Arrow timestamp array holding ts in the given unit.
+// Values are floored to the unit, so instants before 1970 stay in the
+// correct second, millisecond or microsecond. The caller must Release the
+// returned array. A nil mem uses memory.DefaultAllocator.
+func ToArrow(mem memory.Allocator, ts []uts.Timestamp, unit arrow.TimeUnit, tz string) *array.Timestamp {
+	if mem == nil {
+		mem = memory.DefaultAllocator
+	}
+
+	uts.ReportBatch("utarrow.ToArrow", len(ts))
+	b := array.NewTimestampBuilder(mem, DataType(unit, tz))
+	defer b.Release()
+
+	if len(ts) == 0 {
+		return b.NewTimestampArray()
+	}
+
+	values := unsafe.Slice((*arrow.Timestamp)(unsafe.Pointer(&ts[0])), len(ts))
+	if unit == arrow.Nanosecond {
+		b.AppendValues(values, nil)
+		return b.NewTimestampArray()
+	}
+
+	div := int64(unit.Multiplier())
+	b.Reserve(len(ts))
+	for _, v := range values {
+		b.UnsafeAppend(arrow.Timestamp(floorDiv(int64(v), div)))
+	}
+	return b.NewTimestampArray()
+}
+
+// FromArrow converts an Arrow timestamp array to timestamps. Values are
+// interpreted as UTC instants whatever the time zone metadata says. Null
+// slots are returned as zero; use arr.IsNull to tell them apart.
+func FromArrow(arr *array.Timestamp) ([]uts.Timestamp, error) {
+	unit := arr.DataType().(*arrow.TimestampType).Unit
+	values := arr.TimestampValues()
+	uts.ReportBatch("utarrow.FromArrow", len(values))
+
+	out := make([]uts.Timestamp, len(values))
+	if len(values) == 0 {
+		return out, nil
+	}
+
+	if unit == arrow.Nanosecond {
+		copy(unsafe.Slice((*arrow.Timestamp)(unsafe.Pointer(&out[0])), len(out)), values)
+	} else {
+		mul := int64(unit.Multiplier())
+		for i, v := range values {
+			// Null slots may hold any bytes; they are zeroed below.
+			if arr.IsNull(i) {
+				continue
+			}
+			n := int64(v) * mul
+			if n/mul != int64(v) {
+				return nil, ErrOverflow
+			}
+			out[i] = uts.Timestamp(n)
+		}
+	}
+
+	for i := range out {
+		if arr.IsNull(i) {
+			out[i] = 0
+		}
+	}
+	return out, nil
+}
+
+// floorDiv divides a by b, rounding towards negative infinity.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
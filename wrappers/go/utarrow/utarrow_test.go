@@ -0,0 +1,96 @@
+package utarrow
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	uts "github.com/mozrin/universal_timestamp"
+)
+
+func TestRoundTripNanos(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+
+	in := []uts.Timestamp{0, -1, 1734146001123456789}
+	arr := ToArrow(mem, in, arrow.Nanosecond, "UTC")
+	defer arr.Release()
+
+	if tz := arr.DataType().(*arrow.TimestampType).TimeZone; tz != "UTC" {
+		t.Errorf("TimeZone = %q, expected UTC", tz)
+	}
+
+	out, err := FromArrow(arr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range in {
+		if out[i] != in[i] {
+			t.Errorf("value %d = %d, expected %d", i, out[i], in[i])
+		}
+	}
+}
+
+func TestMillis(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+
+	in := []uts.Timestamp{1734146001123456789, -1}
+	arr := ToArrow(mem, in, arrow.Millisecond, "")
+	defer arr.Release()
+
+	if v := arr.Value(0); v != 1734146001123 {
+		t.Errorf("Value(0) = %d, expected 1734146001123", v)
+	}
+	if v := arr.Value(1); v != -1 {
+		t.Errorf("Value(1) = %d, expected -1", v)
+	}
+
+	out, err := FromArrow(arr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out[0] != 1734146001123000000 || out[1] != -1000000 {
+		t.Errorf("FromArrow() = %v", out)
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	arr := ToArrow(nil, nil, arrow.Second, "")
+	defer arr.Release()
+
+	out, err := FromArrow(arr)
+	if err != nil || len(out) != 0 {
+		t.Errorf("FromArrow(empty) = %v, %v", out, err)
+	}
+}
+
+func TestNullSlotGarbage(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewTimestampBuilder(mem, DataType(arrow.Second, ""))
+	defer b.Release()
+	// The null slot holds a value that would overflow if it were converted.
+	b.AppendValues([]arrow.Timestamp{1, math.MaxInt64, 2}, []bool{true, false, true})
+	arr := b.NewTimestampArray()
+	defer arr.Release()
+
+	out, err := FromArrow(arr)
+	if err != nil {
+		t.Fatalf("FromArrow() with garbage in a null slot failed: %v", err)
+	}
+	if out[0] != uts.Timestamp(uts.Second) || out[1] != 0 || out[2] != 2*uts.Timestamp(uts.Second) {
+		t.Errorf("FromArrow() = %v", out)
+	}
+
+	b.AppendValues([]arrow.Timestamp{math.MaxInt64}, nil)
+	valid := b.NewTimestampArray()
+	defer valid.Release()
+	if _, err := FromArrow(valid); !errors.Is(err, ErrOverflow) {
+		t.Errorf("FromArrow(overflow) error = %v, expected ErrOverflow", err)
+	}
+}
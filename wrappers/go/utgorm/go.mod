@@ -0,0 +1,16 @@
+module github.com/mozrin/universal_timestamp/utgorm
+
+go 1.20
+
+require (
+	github.com/mozrin/universal_timestamp v0.0.0
+	gorm.io/gorm v1.31.2
+)
+
+require (
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	golang.org/x/text v0.20.0 // indirect
+)
+
+replace github.com/mozrin/universal_timestamp => ../
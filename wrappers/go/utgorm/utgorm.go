@@ -0,0 +1,75 @@
+// Package utgorm provides a GORM serializer that stores universal_timestamp
+// values in DATETIME, timestamp or timestamptz columns.
+package utgorm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	uts "github.com/mozrin/universal_timestamp"
+	"gorm.io/gorm/schema"
+)
+
+// Name is the serializer name registered with GORM, used in struct tags as
+// `gorm:"serializer:uts;type:timestamptz"`.
+const Name = "uts"
+
+func init() {
+	schema.RegisterSerializer(Name, Serializer{})
+}
+
+// Serializer converts Timestamp and *Timestamp fields to UTC time.Time
+// values on write and back on read. Writes keep the number of fractional
+// digits given by the field's precision tag, or uts.SQLPrecision when the
+// tag is absent; finer digits are floored. GORM treats serialized fields as
+// strings, so give the column type explicitly with a type tag.
+type Serializer struct{}
+
+// Scan implements schema.SerializerInterface. NULL is scanned into a
+// *Timestamp field as nil and is an error for a Timestamp field.
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var n uts.NullTimestamp
+	if err := n.Scan(dbValue); err != nil {
+		return err
+	}
+
+	fieldValue := reflect.New(field.FieldType)
+	switch p := fieldValue.Interface().(type) {
+	case *uts.Timestamp:
+		if !n.Valid {
+			return fmt.Errorf("cannot scan NULL into %s field of type %s", Name, field.FieldType)
+		}
+		*p = n.Timestamp
+	case **uts.Timestamp:
+		if n.Valid {
+			*p = &n.Timestamp
+		}
+	default:
+		return fmt.Errorf("cannot scan into %s field of type %s", Name, field.FieldType)
+	}
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+	return nil
+}
+
+// Value implements schema.SerializerValuerInterface.
+func (Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	var ts uts.Timestamp
+	switch v := fieldValue.(type) {
+	case uts.Timestamp:
+		ts = v
+	case *uts.Timestamp:
+		if v == nil {
+			return nil, nil
+		}
+		ts = *v
+	default:
+		return nil, fmt.Errorf("cannot serialize %T with %s", fieldValue, Name)
+	}
+
+	digits := uts.SQLPrecision()
+	if _, ok := field.TagSettings["PRECISION"]; ok {
+		digits = field.Precision
+	}
+	return ts.TruncateDigits(digits).ToTime(), nil
+}
@@ -0,0 +1,102 @@
+package utgorm
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+	"gorm.io/gorm/schema"
+)
+
+type event struct {
+	ID        uint
+	At        uts.Timestamp  `gorm:"serializer:uts;type:timestamptz"`
+	Millis    uts.Timestamp  `gorm:"serializer:uts;precision:3"`
+	Deleted   *uts.Timestamp `gorm:"serializer:uts"`
+	Published uts.SQLTimestamp
+	Archived  uts.NullTimestamp
+}
+
+func parseEvent(t *testing.T) *schema.Schema {
+	s, err := schema.Parse(&event{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSerializerValue(t *testing.T) {
+	s := parseEvent(t)
+	ctx := context.Background()
+	ts, _ := uts.Parse("2024-12-14T12:00:00.123456789Z")
+	dst := reflect.ValueOf(&event{})
+
+	v, err := s.LookUpField("At").Serializer.Value(ctx, s.LookUpField("At"), dst, ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := v.(time.Time); got.Nanosecond() != 123456000 || got.Location() != time.UTC {
+		t.Errorf("Value(At) = %s, expected microsecond-truncated UTC", got)
+	}
+
+	v, _ = s.LookUpField("Millis").Serializer.Value(ctx, s.LookUpField("Millis"), dst, ts)
+	if got := v.(time.Time); got.Nanosecond() != 123000000 {
+		t.Errorf("Value(Millis) = %s, expected millisecond-truncated", got)
+	}
+
+	v, err = s.LookUpField("Deleted").Serializer.Value(ctx, s.LookUpField("Deleted"), dst, (*uts.Timestamp)(nil))
+	if v != nil || err != nil {
+		t.Errorf("Value(nil) = %v, %v, expected nil", v, err)
+	}
+}
+
+func TestSerializerScan(t *testing.T) {
+	s := parseEvent(t)
+	ctx := context.Background()
+	ts, _ := uts.Parse("2024-12-14T12:00:00.5Z")
+	e := &event{}
+	dst := reflect.ValueOf(e)
+
+	at := s.LookUpField("At")
+	if err := at.Serializer.Scan(ctx, at, dst, ts.ToTime()); err != nil {
+		t.Fatal(err)
+	}
+	if e.At != ts {
+		t.Errorf("Scan(At) = %s, expected %s", e.At.Format(), ts.Format())
+	}
+	if err := at.Serializer.Scan(ctx, at, dst, nil); err == nil {
+		t.Error("Scan(NULL) into a Timestamp field succeeded, expected an error")
+	}
+	if e.At != ts {
+		t.Errorf("Scan(NULL) changed At to %s", e.At.Format())
+	}
+
+	deleted := s.LookUpField("Deleted")
+	if err := deleted.Serializer.Scan(ctx, deleted, dst, "2024-12-14 12:00:00.5"); err != nil {
+		t.Fatal(err)
+	}
+	if e.Deleted == nil || *e.Deleted != ts {
+		t.Errorf("Scan(Deleted) = %v, expected %s", e.Deleted, ts.Format())
+	}
+	if err := deleted.Serializer.Scan(ctx, deleted, dst, nil); err != nil {
+		t.Fatal(err)
+	}
+	if e.Deleted != nil {
+		t.Errorf("Scan(NULL) = %v, expected nil", e.Deleted)
+	}
+}
+
+func TestDataTypes(t *testing.T) {
+	s := parseEvent(t)
+	for _, name := range []string{"Published", "Archived"} {
+		if got := s.LookUpField(name).DataType; got != schema.Time {
+			t.Errorf("%s DataType = %q, expected %q", name, got, schema.Time)
+		}
+	}
+	if got := s.LookUpField("At").DataType; got != "timestamptz" {
+		t.Errorf("At DataType = %q, expected timestamptz", got)
+	}
+}
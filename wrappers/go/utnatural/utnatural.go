@@ -0,0 +1,293 @@
+// Package utnatural parses relative, natural-language date expressions
+// such as "tomorrow at 3pm", "next tuesday", "in 2 weeks" or "3 days ago"
+// into timestamps, for chat-ops and command-line tools.
+package utnatural
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+)
+
+// ErrUnrecognized is returned, wrapped with the offending word, when an
+// expression cannot be understood.
+var ErrUnrecognized = errors.New("unrecognized expression")
+
+// weekdays maps full and abbreviated weekday names to time.Weekday.
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// numberWords maps spelled-out quantities to their values.
+var numberWords = map[string]int{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10,
+}
+
+// tonightHour is the hour "tonight" resolves to when no time is given.
+const tonightHour = 20
+
+// state accumulates the effect of each recognized phrase.
+type state struct {
+	t        time.Time
+	dateSet  bool
+	clockSet bool
+	tonight  bool
+	hour     int
+	minute   int
+	second   int
+}
+
+// Parse interprets s relative to ref, with calendar arithmetic and wall-
+// clock times evaluated in loc. A nil loc is treated as UTC.
+//
+// Day-level phrases ("today", "tomorrow", "friday") resolve to midnight
+// unless a time such as "at 3pm", "15:30" or "noon" is also given;
+// "tonight" is today at 20:00 unless a time is given.
+// Relative phrases ("in 2 hours", "3 days ago", "next week") keep the time
+// of day of ref. A bare weekday means its next occurrence, counting today;
+// "next" and "last" exclude today.
+func Parse(s string, ref uts.Timestamp, loc *time.Location) (uts.Timestamp, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	st := state{t: ref.In(loc)}
+
+	tokens := strings.Fields(strings.ToLower(strings.ReplaceAll(s, ",", " ")))
+	if len(tokens) == 0 {
+		return 0, fmt.Errorf("%w: empty input", ErrUnrecognized)
+	}
+
+	for i := 0; i < len(tokens); {
+		n, err := st.consume(tokens, i)
+		if err != nil {
+			return 0, err
+		}
+		i += n
+	}
+
+	t := st.t
+	switch {
+	case st.clockSet:
+		t = time.Date(t.Year(), t.Month(), t.Day(), st.hour, st.minute, st.second, 0, loc)
+	case st.tonight:
+		t = time.Date(t.Year(), t.Month(), t.Day(), tonightHour, 0, 0, 0, loc)
+	case st.dateSet:
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	}
+	return uts.FromTime(t), nil
+}
+
+// consume applies the phrase starting at tokens[i] and returns the number
+// of tokens it used.
+func (st *state) consume(tokens []string, i int) (int, error) {
+	tok := tokens[i]
+	switch tok {
+	case "now":
+		return 1, nil
+	case "today":
+		st.dateSet = true
+		return 1, nil
+	case "tonight":
+		st.dateSet = true
+		st.tonight = true
+		return 1, nil
+	case "tomorrow":
+		st.t = st.t.AddDate(0, 0, 1)
+		st.dateSet = true
+		return 1, nil
+	case "yesterday":
+		st.t = st.t.AddDate(0, 0, -1)
+		st.dateSet = true
+		return 1, nil
+	case "at", "on", "this":
+		return 1, nil
+	case "in":
+		used, err := st.quantity(tokens, i+1, 1)
+		if err != nil {
+			return 0, err
+		}
+		return 1 + used, nil
+	case "next", "last":
+		if i+1 >= len(tokens) {
+			break
+		}
+		sign := 1
+		if tok == "last" {
+			sign = -1
+		}
+		if wd, ok := weekdays[tokens[i+1]]; ok {
+			st.weekday(wd, sign)
+			return 2, nil
+		}
+		if st.addUnit(strings.TrimSuffix(tokens[i+1], "s"), sign) {
+			return 2, nil
+		}
+	}
+
+	if wd, ok := weekdays[tok]; ok {
+		st.weekday(wd, 0)
+		return 1, nil
+	}
+
+	if used, ok := st.clock(tokens, i); ok {
+		return used, nil
+	}
+
+	if _, ok := parseNumber(tok); ok && i+2 < len(tokens) && tokens[i+2] == "ago" {
+		used, err := st.quantity(tokens, i, -1)
+		if err != nil {
+			return 0, err
+		}
+		return used + 1, nil
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnrecognized, tok)
+}
+
+// quantity applies "N unit" starting at tokens[i], multiplied by sign, and
+// returns the number of tokens it used.
+func (st *state) quantity(tokens []string, i, sign int) (int, error) {
+	if i+1 >= len(tokens) {
+		return 0, fmt.Errorf("%w: incomplete quantity", ErrUnrecognized)
+	}
+	n, ok := parseNumber(tokens[i])
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnrecognized, tokens[i])
+	}
+	unit := strings.TrimSuffix(tokens[i+1], "s")
+	if !st.addUnit(unit, n*sign) {
+		return 0, fmt.Errorf("%w: %q", ErrUnrecognized, tokens[i+1])
+	}
+	return 2, nil
+}
+
+// addUnit moves the anchor by n of the named unit. Calendar units follow
+// the wall clock in the target zone; shorter units are exact durations.
+func (st *state) addUnit(unit string, n int) bool {
+	switch unit {
+	case "second", "sec":
+		st.t = st.t.Add(time.Duration(n) * time.Second)
+	case "minute", "min":
+		st.t = st.t.Add(time.Duration(n) * time.Minute)
+	case "hour", "hr":
+		st.t = st.t.Add(time.Duration(n) * time.Hour)
+	case "day":
+		st.t = st.t.AddDate(0, 0, n)
+	case "week":
+		st.t = st.t.AddDate(0, 0, 7*n)
+	case "fortnight":
+		st.t = st.t.AddDate(0, 0, 14*n)
+	case "month":
+		st.t = st.t.AddDate(0, n, 0)
+	case "year":
+		st.t = st.t.AddDate(n, 0, 0)
+	default:
+		return false
+	}
+	return true
+}
+
+// weekday moves the anchor to wd. A direction of 0 picks the next
+// occurrence including today, 1 the next excluding today and -1 the
+// previous excluding today.
+func (st *state) weekday(wd time.Weekday, direction int) {
+	delta := (int(wd) - int(st.t.Weekday()) + 7) % 7
+	switch {
+	case direction > 0 && delta == 0:
+		delta = 7
+	case direction < 0:
+		delta -= 7
+	}
+	st.t = st.t.AddDate(0, 0, delta)
+	st.dateSet = true
+}
+
+// clock recognizes a time of day ("noon", "3pm", "3 pm", "15:30",
+// "3:30:15am") starting at tokens[i].
+func (st *state) clock(tokens []string, i int) (int, bool) {
+	tok := tokens[i]
+	switch tok {
+	case "noon", "midday":
+		st.setClock(12, 0, 0)
+		return 1, true
+	case "midnight":
+		st.setClock(0, 0, 0)
+		return 1, true
+	}
+
+	used := 1
+	suffix := ""
+	switch {
+	case strings.HasSuffix(tok, "am"), strings.HasSuffix(tok, "pm"):
+		suffix = tok[len(tok)-2:]
+		tok = tok[:len(tok)-2]
+	case i+1 < len(tokens) && (tokens[i+1] == "am" || tokens[i+1] == "pm"):
+		suffix = tokens[i+1]
+		used = 2
+	case !strings.Contains(tok, ":"):
+		if i == 0 || tokens[i-1] != "at" {
+			return 0, false
+		}
+	}
+
+	parts := strings.Split(tok, ":")
+	if len(parts) > 3 {
+		return 0, false
+	}
+	var fields [3]int
+	for j, p := range parts {
+		v, err := strconv.Atoi(p)
+		if err != nil || v < 0 || (j > 0 && (len(p) != 2 || v > 59)) {
+			return 0, false
+		}
+		fields[j] = v
+	}
+
+	hour := fields[0]
+	switch suffix {
+	case "":
+		if hour > 23 {
+			return 0, false
+		}
+	default:
+		if hour < 1 || hour > 12 {
+			return 0, false
+		}
+		hour %= 12
+		if suffix == "pm" {
+			hour += 12
+		}
+	}
+
+	st.setClock(hour, fields[1], fields[2])
+	return used, true
+}
+
+// setClock records an explicit time of day.
+func (st *state) setClock(hour, minute, second int) {
+	st.clockSet = true
+	st.hour, st.minute, st.second = hour, minute, second
+}
+
+// parseNumber reads a quantity written as digits or as a word.
+func parseNumber(tok string) (int, bool) {
+	if n, ok := numberWords[tok]; ok {
+		return n, true
+	}
+	n, err := strconv.Atoi(tok)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n, true
+}
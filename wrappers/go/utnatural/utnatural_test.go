@@ -0,0 +1,70 @@
+package utnatural
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+)
+
+func TestParse(t *testing.T) {
+	ref, _ := uts.Parse("2024-12-14T10:20:30Z")
+
+	cases := map[string]string{
+		"now":                  "2024-12-14T10:20:30Z",
+		"today":                "2024-12-14T00:00:00Z",
+		"tonight":              "2024-12-14T20:00:00Z",
+		"tonight at 11pm":      "2024-12-14T23:00:00Z",
+		"tomorrow at 3pm":      "2024-12-15T15:00:00Z",
+		"yesterday at noon":    "2024-12-13T12:00:00Z",
+		"tomorrow at 3:30 pm":  "2024-12-15T15:30:00Z",
+		"at 15:45":             "2024-12-14T15:45:00Z",
+		"midnight":             "2024-12-14T00:00:00Z",
+		"in 2 weeks":           "2024-12-28T10:20:30Z",
+		"in an hour":           "2024-12-14T11:20:30Z",
+		"3 days ago":           "2024-12-11T10:20:30Z",
+		"in 90 minutes":        "2024-12-14T11:50:30Z",
+		"next tuesday":         "2024-12-17T00:00:00Z",
+		"saturday":             "2024-12-14T00:00:00Z",
+		"next saturday":        "2024-12-21T00:00:00Z",
+		"last saturday":        "2024-12-07T00:00:00Z",
+		"last friday at 9am":   "2024-12-13T09:00:00Z",
+		"next month":           "2025-01-14T10:20:30Z",
+		"Next Tuesday, at 9am": "2024-12-17T09:00:00Z",
+	}
+	for input, expected := range cases {
+		ts, err := Parse(input, ref, nil)
+		if err != nil {
+			t.Errorf("Parse(%q) failed: %v", input, err)
+			continue
+		}
+		if got := ts.Format(); got != expected {
+			t.Errorf("Parse(%q) = %s, expected %s", input, got, expected)
+		}
+	}
+}
+
+func TestParseZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	ref, _ := uts.Parse("2024-03-09T17:00:00Z")
+	ts, err := Parse("tomorrow at 9am", ref, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ts.Format(); got != "2024-03-10T13:00:00Z" {
+		t.Errorf("tomorrow at 9am across DST = %s", got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, input := range []string{"", "someday", "in 2 fortnights later", "at 25:00", "13pm", "in two"} {
+		if _, err := Parse(input, 0, nil); !errors.Is(err, ErrUnrecognized) {
+			t.Errorf("Parse(%q) error = %v, expected ErrUnrecognized", input, err)
+		}
+	}
+}
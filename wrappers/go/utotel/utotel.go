@@ -0,0 +1,65 @@
+// Package utotel bridges universal_timestamp and OpenTelemetry tracing so
+// spans and events can be stamped from the same Clock as application code.
+package utotel
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	uts "github.com/mozrin/universal_timestamp"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrOutOfRange is returned when a value cannot be represented as an
+// OpenTelemetry epoch-nanosecond timestamp or as a Timestamp.
+var ErrOutOfRange = errors.New("timestamp out of range for OpenTelemetry")
+
+// ToEpochNanos converts ts to the uint64 Unix nanoseconds used by the
+// OpenTelemetry protocol. Instants before 1970 cannot be represented.
+func ToEpochNanos(ts uts.Timestamp) (uint64, error) {
+	if ts < 0 {
+		return 0, ErrOutOfRange
+	}
+	return uint64(ts), nil
+}
+
+// FromEpochNanos converts OpenTelemetry uint64 Unix nanoseconds to a
+// Timestamp. Values beyond the year 2262 cannot be represented.
+func FromEpochNanos(n uint64) (uts.Timestamp, error) {
+	if n > math.MaxInt64 {
+		return 0, ErrOutOfRange
+	}
+	return uts.Timestamp(n), nil
+}
+
+// WithClock returns a span or event option carrying the current time of
+// clock. A nil clock uses uts.DefaultClock.
+func WithClock(clock uts.Clock) trace.SpanEventOption {
+	if clock == nil {
+		clock = uts.DefaultClock()
+	}
+	return trace.WithTimestamp(clock.Now().ToTime())
+}
+
+// Start starts a span whose start time is read from clock. The clock's
+// time is passed after opts, so it overrides any timestamp among them.
+func Start(ctx context.Context, tracer trace.Tracer, clock uts.Clock, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, withOption[trace.SpanStartOption](opts, WithClock(clock))...)
+}
+
+// End ends span with an end time read from clock.
+func End(span trace.Span, clock uts.Clock, opts ...trace.SpanEndOption) {
+	span.End(withOption[trace.SpanEndOption](opts, WithClock(clock))...)
+}
+
+// AddEvent records an event on span stamped with the time read from clock.
+func AddEvent(span trace.Span, clock uts.Clock, name string, opts ...trace.EventOption) {
+	span.AddEvent(name, withOption[trace.EventOption](opts, WithClock(clock))...)
+}
+
+// withOption returns a new slice holding opts followed by last, leaving the
+// caller's backing array untouched.
+func withOption[T any](opts []T, last T) []T {
+	return append(opts[:len(opts):len(opts)], last)
+}
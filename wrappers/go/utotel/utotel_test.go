@@ -0,0 +1,116 @@
+package utotel
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestEpochNanos(t *testing.T) {
+	n, err := ToEpochNanos(1734146001123456789)
+	if err != nil || n != 1734146001123456789 {
+		t.Errorf("ToEpochNanos() = %d, %v", n, err)
+	}
+	if _, err := ToEpochNanos(-1); err != ErrOutOfRange {
+		t.Errorf("expected ErrOutOfRange for pre-epoch, got %v", err)
+	}
+
+	ts, err := FromEpochNanos(42)
+	if err != nil || ts != 42 {
+		t.Errorf("FromEpochNanos() = %d, %v", ts, err)
+	}
+	if _, err := FromEpochNanos(math.MaxUint64); err != ErrOutOfRange {
+		t.Errorf("expected ErrOutOfRange for overflow, got %v", err)
+	}
+}
+
+func TestWithClock(t *testing.T) {
+	ts, _ := uts.Parse("2024-12-14T12:00:00Z")
+	clock := uts.NewManualClock(ts)
+
+	cfg := trace.NewSpanStartConfig(WithClock(clock))
+	if !cfg.Timestamp().Equal(ts.ToTime()) {
+		t.Errorf("start timestamp = %v, expected %v", cfg.Timestamp(), ts.ToTime())
+	}
+
+	ev := trace.NewEventConfig(WithClock(clock))
+	if !ev.Timestamp().Equal(ts.ToTime()) {
+		t.Errorf("event timestamp = %v, expected %v", ev.Timestamp(), ts.ToTime())
+	}
+}
+
+// recorder is an in-memory tracer that keeps the span and event times it
+// is given.
+type recorder struct {
+	noop.Tracer
+	start time.Time
+	span  *recordedSpan
+}
+
+func (r *recorder) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	cfg := trace.NewSpanStartConfig(opts...)
+	r.start = cfg.Timestamp()
+	r.span = &recordedSpan{}
+	return trace.ContextWithSpan(ctx, r.span), r.span
+}
+
+type recordedSpan struct {
+	noop.Span
+	event time.Time
+	end   time.Time
+}
+
+func (s *recordedSpan) AddEvent(name string, opts ...trace.EventOption) {
+	cfg := trace.NewEventConfig(opts...)
+	s.event = cfg.Timestamp()
+}
+
+func (s *recordedSpan) End(opts ...trace.SpanEndOption) {
+	cfg := trace.NewSpanEndConfig(opts...)
+	s.end = cfg.Timestamp()
+}
+
+func TestStartEnd(t *testing.T) {
+	start, _ := uts.Parse("2024-12-14T12:00:00Z")
+	clock := uts.NewManualClock(start)
+	tracer := &recorder{}
+
+	ctx, span := Start(context.Background(), tracer, clock, "op")
+	clock.Advance(uts.Second)
+	AddEvent(span, clock, "event")
+	clock.Advance(uts.Second)
+	End(span, clock)
+
+	if trace.SpanFromContext(ctx) != span {
+		t.Error("Start() context does not carry the span")
+	}
+	if !tracer.start.Equal(start.ToTime()) {
+		t.Errorf("span start = %v, expected %v", tracer.start, start.ToTime())
+	}
+	if expected := (start + uts.Timestamp(uts.Second)).ToTime(); !tracer.span.event.Equal(expected) {
+		t.Errorf("event time = %v, expected %v", tracer.span.event, expected)
+	}
+	if expected := (start + uts.Timestamp(2*uts.Second)).ToTime(); !tracer.span.end.Equal(expected) {
+		t.Errorf("span end = %v, expected %v", tracer.span.end, expected)
+	}
+}
+
+func TestStartKeepsCallerOptions(t *testing.T) {
+	clock := uts.NewManualClock(0)
+	tracer := &recorder{}
+
+	// Spare capacity in opts must not be written to.
+	opts := make([]trace.SpanStartOption, 1, 2)
+	opts[0] = trace.WithSpanKind(trace.SpanKindServer)
+	opts[:2][1] = trace.WithSpanKind(trace.SpanKindClient)
+
+	Start(context.Background(), tracer, clock, "op", opts...)
+	if cfg := trace.NewSpanStartConfig(opts[:2][1]); cfg.SpanKind() != trace.SpanKindClient {
+		t.Error("Start() wrote into the spare capacity of opts")
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/mozrin/universal_timestamp/utpgx
+
+go 1.25.0
+
+require (
+	github.com/jackc/pgx/v5 v5.11.0
+	github.com/mozrin/universal_timestamp v0.0.0
+)
+
+replace github.com/mozrin/universal_timestamp => ../
@@ -0,0 +1,197 @@
+// Package utpgx registers universal_timestamp types with the pgx v5 type map
+// so they can be used directly as query arguments and scan targets for
+// timestamp and timestamptz columns.
+package utpgx
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	uts "github.com/mozrin/universal_timestamp"
+)
+
+var (
+	minTime = time.Unix(0, math.MinInt64)
+	maxTime = time.Unix(0, math.MaxInt64)
+)
+
+// Register installs codecs for uts.Timestamp and uts.ZonedTimestamp on the
+// timestamp and timestamptz types of m, and makes timestamptz the default
+// PostgreSQL type for both. It is typically called from
+// pgxpool.Config.AfterConnect with conn.TypeMap().
+//
+// PostgreSQL 'infinity' and '-infinity' map to math.MaxInt64 and
+// math.MinInt64 in both directions. PostgreSQL stores microseconds, so
+// sub-microsecond digits are truncated on encode. Scanning a timestamp
+// without time zone interprets its wall clock as UTC.
+func Register(m *pgtype.Map) {
+	for _, oid := range []uint32{pgtype.TimestampOID, pgtype.TimestamptzOID} {
+		t, ok := m.TypeForOID(oid)
+		if !ok {
+			continue
+		}
+		if _, done := t.Codec.(*codec); done {
+			continue
+		}
+		m.RegisterType(&pgtype.Type{Name: t.Name, OID: t.OID, Codec: &codec{Codec: t.Codec}})
+	}
+	m.RegisterDefaultPgType(uts.Timestamp(0), "timestamptz")
+	m.RegisterDefaultPgType(uts.ZonedTimestamp{}, "timestamptz")
+}
+
+// codec wraps the built-in timestamp codecs, routing universal_timestamp
+// values through adapter types that implement the pgtype scanner and valuer
+// interfaces. ZonedTimestamp implements sql.Scanner, which pgx would
+// otherwise prefer, so the adapters must be selected here rather than via
+// the map's wrap functions.
+type codec struct {
+	pgtype.Codec
+}
+
+func (c *codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	switch value.(type) {
+	case uts.Timestamp:
+		if next := c.Codec.PlanEncode(m, oid, format, timestamp(0)); next != nil {
+			return &encodeTimestampPlan{next: next}
+		}
+	case uts.ZonedTimestamp:
+		if next := c.Codec.PlanEncode(m, oid, format, zoned{}); next != nil {
+			return &encodeZonedPlan{next: next}
+		}
+	}
+	return c.Codec.PlanEncode(m, oid, format, value)
+}
+
+func (c *codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	switch target.(type) {
+	case *uts.Timestamp:
+		if next := c.Codec.PlanScan(m, oid, format, new(timestamp)); next != nil {
+			return &scanTimestampPlan{next: next}
+		}
+	case *uts.ZonedTimestamp:
+		if next := c.Codec.PlanScan(m, oid, format, new(zoned)); next != nil {
+			return &scanZonedPlan{next: next}
+		}
+	}
+	return c.Codec.PlanScan(m, oid, format, target)
+}
+
+type encodeTimestampPlan struct{ next pgtype.EncodePlan }
+
+func (p *encodeTimestampPlan) Encode(value any, buf []byte) ([]byte, error) {
+	return p.next.Encode(timestamp(value.(uts.Timestamp)), buf)
+}
+
+type encodeZonedPlan struct{ next pgtype.EncodePlan }
+
+func (p *encodeZonedPlan) Encode(value any, buf []byte) ([]byte, error) {
+	return p.next.Encode(zoned(value.(uts.ZonedTimestamp)), buf)
+}
+
+type scanTimestampPlan struct{ next pgtype.ScanPlan }
+
+func (p *scanTimestampPlan) Scan(src []byte, target any) error {
+	return p.next.Scan(src, (*timestamp)(target.(*uts.Timestamp)))
+}
+
+type scanZonedPlan struct{ next pgtype.ScanPlan }
+
+func (p *scanZonedPlan) Scan(src []byte, target any) error {
+	return p.next.Scan(src, (*zoned)(target.(*uts.ZonedTimestamp)))
+}
+
+// timestamp adapts uts.Timestamp to the pgtype timestamp interfaces.
+type timestamp uts.Timestamp
+
+func (t timestamp) TimestampValue() (pgtype.Timestamp, error) {
+	v, inf := toTime(uts.Timestamp(t), time.UTC)
+	return pgtype.Timestamp{Time: v, InfinityModifier: inf, Valid: true}, nil
+}
+
+func (t timestamp) TimestamptzValue() (pgtype.Timestamptz, error) {
+	v, inf := toTime(uts.Timestamp(t), time.UTC)
+	return pgtype.Timestamptz{Time: v, InfinityModifier: inf, Valid: true}, nil
+}
+
+func (t *timestamp) ScanTimestamp(v pgtype.Timestamp) error {
+	if !v.Valid {
+		return errors.New("cannot scan NULL into *universal_timestamp.Timestamp")
+	}
+	ts, err := fromTime(v.Time, v.InfinityModifier)
+	*t = timestamp(ts)
+	return err
+}
+
+func (t *timestamp) ScanTimestamptz(v pgtype.Timestamptz) error {
+	if !v.Valid {
+		return errors.New("cannot scan NULL into *universal_timestamp.Timestamp")
+	}
+	ts, err := fromTime(v.Time, v.InfinityModifier)
+	*t = timestamp(ts)
+	return err
+}
+
+// zoned adapts uts.ZonedTimestamp to the pgtype timestamp interfaces. A
+// timestamp without time zone stores the wall clock in the value's location.
+type zoned uts.ZonedTimestamp
+
+func (z zoned) TimestampValue() (pgtype.Timestamp, error) {
+	v, inf := toTime(z.Instant, z.Location)
+	return pgtype.Timestamp{Time: v, InfinityModifier: inf, Valid: true}, nil
+}
+
+func (z zoned) TimestamptzValue() (pgtype.Timestamptz, error) {
+	v, inf := toTime(z.Instant, z.Location)
+	return pgtype.Timestamptz{Time: v, InfinityModifier: inf, Valid: true}, nil
+}
+
+func (z *zoned) ScanTimestamp(v pgtype.Timestamp) error {
+	if !v.Valid {
+		return errors.New("cannot scan NULL into *universal_timestamp.ZonedTimestamp")
+	}
+	ts, err := fromTime(v.Time, v.InfinityModifier)
+	*z = zoned(uts.NewZoned(ts, time.UTC))
+	return err
+}
+
+func (z *zoned) ScanTimestamptz(v pgtype.Timestamptz) error {
+	if !v.Valid {
+		return errors.New("cannot scan NULL into *universal_timestamp.ZonedTimestamp")
+	}
+	ts, err := fromTime(v.Time, v.InfinityModifier)
+	loc := time.UTC
+	if v.InfinityModifier == pgtype.Finite {
+		loc = v.Time.Location()
+	}
+	*z = zoned(uts.NewZoned(ts, loc))
+	return err
+}
+
+// toTime converts ts to a time.Time in loc, mapping the int64 extremes to
+// PostgreSQL infinities.
+func toTime(ts uts.Timestamp, loc *time.Location) (time.Time, pgtype.InfinityModifier) {
+	switch ts {
+	case math.MaxInt64:
+		return time.Time{}, pgtype.Infinity
+	case math.MinInt64:
+		return time.Time{}, pgtype.NegativeInfinity
+	}
+	return ts.In(loc), pgtype.Finite
+}
+
+// fromTime converts a scanned value to a Timestamp, mapping PostgreSQL
+// infinities to the int64 extremes.
+func fromTime(t time.Time, inf pgtype.InfinityModifier) (uts.Timestamp, error) {
+	switch inf {
+	case pgtype.Infinity:
+		return math.MaxInt64, nil
+	case pgtype.NegativeInfinity:
+		return math.MinInt64, nil
+	}
+	if t.Before(minTime) || t.After(maxTime) {
+		return 0, uts.ErrOutOfRange
+	}
+	return uts.FromTime(t), nil
+}
@@ -0,0 +1,162 @@
+package utpgx
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	uts "github.com/mozrin/universal_timestamp"
+)
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	Register(m)
+	return m
+}
+
+func TestTimestampRoundTrip(t *testing.T) {
+	m := newMap()
+	ts, _ := uts.Parse("2024-12-14T12:00:00.123456Z")
+	values := []uts.Timestamp{ts, 0, math.MaxInt64, math.MinInt64}
+
+	for _, oid := range []uint32{pgtype.TimestampOID, pgtype.TimestamptzOID} {
+		for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+			for _, want := range values {
+				buf, err := m.Encode(oid, format, want, nil)
+				if err != nil {
+					t.Fatalf("Encode(%d, %d, %d) error: %v", oid, format, want, err)
+				}
+				var got uts.Timestamp
+				if err := m.Scan(oid, format, buf, &got); err != nil {
+					t.Fatalf("Scan(%d, %d, %q) error: %v", oid, format, buf, err)
+				}
+				if got != want {
+					t.Errorf("round trip (%d, %d) = %d, expected %d", oid, format, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestTimestampInfinityText(t *testing.T) {
+	m := newMap()
+	buf, err := m.Encode(pgtype.TimestamptzOID, pgtype.TextFormatCode, uts.Timestamp(math.MaxInt64), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "infinity" {
+		t.Errorf("Encode(MaxInt64) = %q, expected infinity", buf)
+	}
+
+	var got uts.Timestamp
+	if err := m.Scan(pgtype.TimestampOID, pgtype.TextFormatCode, []byte("-infinity"), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != math.MinInt64 {
+		t.Errorf("Scan(-infinity) = %d, expected MinInt64", got)
+	}
+}
+
+func TestTimestampTruncatesToMicros(t *testing.T) {
+	m := newMap()
+	ts, _ := uts.Parse("2024-12-14T12:00:00.123456789Z")
+	buf, err := m.Encode(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, ts, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got uts.Timestamp
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, buf, &got); err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := uts.Parse("2024-12-14T12:00:00.123456Z"); got != want {
+		t.Errorf("round trip = %s, expected %s", got.Format(), want.Format())
+	}
+}
+
+func TestTimestampNull(t *testing.T) {
+	m := newMap()
+	var ts uts.Timestamp
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, nil, &ts); err == nil {
+		t.Error("Scan(NULL) into *Timestamp should fail")
+	}
+
+	p := new(uts.Timestamp)
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, nil, &p); err != nil {
+		t.Fatal(err)
+	}
+	if p != nil {
+		t.Errorf("Scan(NULL) into **Timestamp = %v, expected nil", p)
+	}
+
+	buf, err := m.Encode(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, (*uts.Timestamp)(nil), nil)
+	if err != nil || buf != nil {
+		t.Errorf("Encode(nil) = %v, %v, expected NULL", buf, err)
+	}
+}
+
+func TestTimestampOutOfRange(t *testing.T) {
+	m := newMap()
+	var got uts.Timestamp
+	err := m.Scan(pgtype.TimestamptzOID, pgtype.TextFormatCode, []byte("3000-01-01 00:00:00Z"), &got)
+	if !errors.Is(err, uts.ErrOutOfRange) {
+		t.Errorf("Scan(3000-01-01) error = %v, expected ErrOutOfRange", err)
+	}
+}
+
+func TestZonedTimestamp(t *testing.T) {
+	m := newMap()
+	z := uts.NewZoned(0, time.FixedZone("", 2*3600))
+
+	buf, err := m.Encode(pgtype.TimestampOID, pgtype.TextFormatCode, z, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "1970-01-01 02:00:00" {
+		t.Errorf("Encode(timestamp) = %q, expected wall clock 1970-01-01 02:00:00", buf)
+	}
+
+	buf, err = m.Encode(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, z, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got uts.ZonedTimestamp
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, buf, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Instant != z.Instant || got.Location == nil {
+		t.Errorf("round trip = %+v, expected instant %d", got, z.Instant)
+	}
+
+	inf := uts.NewZoned(math.MaxInt64, nil)
+	buf, err = m.Encode(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, inf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, buf, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Instant != math.MaxInt64 {
+		t.Errorf("Scan(infinity) = %d, expected MaxInt64", got.Instant)
+	}
+}
+
+func TestRegisterDefaultType(t *testing.T) {
+	m := newMap()
+	for _, v := range []any{uts.Timestamp(0), uts.ZonedTimestamp{}} {
+		typ, ok := m.TypeForValue(v)
+		if !ok || typ.OID != pgtype.TimestamptzOID {
+			t.Errorf("TypeForValue(%T) = %v, expected timestamptz", v, typ)
+		}
+	}
+	Register(m)
+	typ, _ := m.TypeForOID(pgtype.TimestamptzOID)
+	c, ok := typ.Codec.(*codec)
+	if !ok {
+		t.Fatal("Register() did not install the codec")
+	}
+	if _, nested := c.Codec.(*codec); nested {
+		t.Error("Register() should be idempotent")
+	}
+}
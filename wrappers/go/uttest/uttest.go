@@ -0,0 +1,20 @@
+// Package uttest provides test assertions for timestamps.
+package uttest
+
+import (
+	"testing"
+
+	uts "github.com/mozrin/universal_timestamp"
+)
+
+// EqualWithin reports a test error unless got is within tol of want. It
+// returns whether the assertion held, so callers can stop early.
+func EqualWithin(tb testing.TB, got, want uts.Timestamp, tol uts.Duration) bool {
+	tb.Helper()
+	if got.EqualWithin(want, tol) {
+		return true
+	}
+	tb.Errorf("timestamp %s is not within %s of %s (off by %s)",
+		got.Format(), tol, want.Format(), uts.Duration(got-want))
+	return false
+}
@@ -0,0 +1,42 @@
+package uttest
+
+import (
+	"fmt"
+	"testing"
+
+	uts "github.com/mozrin/universal_timestamp"
+)
+
+// recorder captures failures instead of failing the enclosing test.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestEqualWithin(t *testing.T) {
+	ts, err := uts.Parse("2024-12-14T12:00:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &recorder{TB: t}
+	if !EqualWithin(r, ts+5, ts, 10) || len(r.failures) != 0 {
+		t.Errorf("EqualWithin() failed for close timestamps: %v", r.failures)
+	}
+	if EqualWithin(r, ts+uts.Timestamp(uts.Second), ts, uts.Millisecond) {
+		t.Error("EqualWithin() passed for distant timestamps")
+	}
+	if len(r.failures) != 1 {
+		t.Fatalf("recorded %d failures, expected 1", len(r.failures))
+	}
+	expected := "timestamp 2024-12-14T12:00:01Z is not within PT0.001S of 2024-12-14T12:00:00Z (off by PT1S)"
+	if r.failures[0] != expected {
+		t.Errorf("failure message = %q, expected %q", r.failures[0], expected)
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/mozrin/universal_timestamp/utzap
+
+go 1.20
+
+require (
+	github.com/mozrin/universal_timestamp v0.0.0
+	go.uber.org/zap v1.28.0
+)
+
+require go.uber.org/multierr v1.10.0 // indirect
+
+replace github.com/mozrin/universal_timestamp => ../
@@ -0,0 +1,56 @@
+// Package utzap provides zap field constructors for universal_timestamp
+// values.
+package utzap
+
+import (
+	"sync"
+	"time"
+
+	uts "github.com/mozrin/universal_timestamp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// maxFormatLen is the length of the longest canonical timestamp string.
+const maxFormatLen = 32
+
+// Bounds of the Timestamp range, in Unix nanoseconds.
+const (
+	minNanos = -1 << 63
+	maxNanos = 1<<63 - 1
+)
+
+// Timestamp returns a field that logs ts under key as its canonical
+// ISO-8601 string, such as "2024-12-14T12:00:00.123456789Z", whatever the
+// encoder's EncodeTime. The text is rendered with AppendFormat into a stack
+// buffer, so the string itself is the field's only allocation and
+// encoding it allocates nothing further.
+func Timestamp(key string, ts uts.Timestamp) zap.Field {
+	var buf [maxFormatLen]byte
+	return zap.String(key, string(ts.AppendFormat(buf[:0])))
+}
+
+// formatBuffers holds scratch buffers for TimeEncoder.
+var formatBuffers = sync.Pool{
+	New: func() interface{} { return new([maxFormatLen]byte) },
+}
+
+// TimeEncoder is a zapcore.TimeEncoder writing times, such as those of
+// zap.Time fields, in the canonical ISO-8601 form of
+// Timestamp.AppendFormat, such as "2024-12-14T12:00:00.5Z". It formats into
+// a pooled buffer, so encoding does not allocate. Times outside the Timestamp range are written in
+// RFC 3339 form.
+func TimeEncoder(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
+	if t.Before(time.Unix(0, minNanos)) || t.After(time.Unix(0, maxNanos)) {
+		enc.AppendString(t.UTC().Format(time.RFC3339Nano))
+		return
+	}
+	buf := formatBuffers.Get().(*[maxFormatLen]byte)
+	enc.AppendByteString(uts.FromTime(t).AppendFormat(buf[:0]))
+	formatBuffers.Put(buf)
+}
+
+// Duration returns a field that logs d under key in ISO-8601 notation.
+func Duration(key string, d uts.Duration) zap.Field {
+	return zap.String(key, d.String())
+}
@@ -0,0 +1,84 @@
+package utzap
+
+import (
+	"strings"
+	"testing"
+
+	uts "github.com/mozrin/universal_timestamp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestTimestamp(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	logger := zap.New(core)
+
+	ts, _ := uts.Parse("2024-12-14T12:00:00.5Z")
+	logger.Info("event", Timestamp("at", ts), Duration("took", 90*uts.Second))
+
+	fields := logs.All()[0].ContextMap()
+	if fields["at"] != "2024-12-14T12:00:00.5Z" {
+		t.Errorf("at = %v", fields["at"])
+	}
+	if fields["took"] != "PT1M30S" {
+		t.Errorf("took = %v", fields["took"])
+	}
+}
+
+func TestTimestampProductionEncoder(t *testing.T) {
+	ts, _ := uts.Parse("2024-12-14T12:00:00.123456789Z")
+	enc := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	buf, err := enc.EncodeEntry(zapcore.Entry{Message: "event"}, []zapcore.Field{Timestamp("at", ts)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer buf.Free()
+	if got := buf.String(); !strings.Contains(got, `"at":"2024-12-14T12:00:00.123456789Z"`) {
+		t.Errorf("EncodeEntry() = %s, expected the canonical timestamp", got)
+	}
+}
+
+func newJSONEncoder() zapcore.Encoder {
+	return zapcore.NewJSONEncoder(zapcore.EncoderConfig{MessageKey: "msg", EncodeTime: TimeEncoder})
+}
+
+func TestTimeEncoder(t *testing.T) {
+	ts, _ := uts.Parse("2024-12-14T12:00:00.123456789Z")
+	buf, err := newJSONEncoder().EncodeEntry(zapcore.Entry{Message: "event"}, []zapcore.Field{zap.Time("at", ts.ToTime())})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer buf.Free()
+	if got := strings.TrimSpace(buf.String()); got != `{"msg":"event","at":"2024-12-14T12:00:00.123456789Z"}` {
+		t.Errorf("EncodeEntry() = %s", got)
+	}
+}
+
+func TestTimestampAllocs(t *testing.T) {
+	ts, _ := uts.Parse("2024-12-14T12:00:00.123456789Z")
+	if allocs := testing.AllocsPerRun(100, func() {
+		_ = Timestamp("at", ts)
+	}); allocs > 1 {
+		t.Errorf("Timestamp() allocated %.0f times, expected at most 1", allocs)
+	}
+
+	enc := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	entry := zapcore.Entry{Message: "event"}
+	fields := []zapcore.Field{Timestamp("at", ts)}
+	if allocs := testing.AllocsPerRun(100, func() {
+		buf, _ := enc.EncodeEntry(entry, fields)
+		buf.Free()
+	}); allocs != 0 {
+		t.Errorf("encoding a Timestamp field allocated %.0f times, expected 0", allocs)
+	}
+
+	timeEnc := newJSONEncoder()
+	timeFields := []zapcore.Field{zap.Time("at", ts.ToTime())}
+	if allocs := testing.AllocsPerRun(100, func() {
+		buf, _ := timeEnc.EncodeEntry(entry, timeFields)
+		buf.Free()
+	}); allocs != 0 {
+		t.Errorf("TimeEncoder allocated %.0f times, expected 0", allocs)
+	}
+}
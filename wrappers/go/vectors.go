@@ -0,0 +1,59 @@
+package universal_timestamp
+
+// TestVector is a single parser input together with the expected outcome.
+// The JSON encoding of TestVectors can be shared with other language
+// wrappers so all of them are checked against the same data.
+type TestVector struct {
+	// Name briefly describes what the vector exercises.
+	Name string `json:"name"`
+	// Input is the string handed to the strict parser.
+	Input string `json:"input"`
+	// Valid reports whether strict parsing is expected to succeed.
+	Valid bool `json:"valid"`
+	// Nanos is the expected Unix nanosecond value for valid inputs.
+	Nanos int64 `json:"nanos"`
+	// Canonical is the expected Format output for valid inputs.
+	Canonical string `json:"canonical,omitempty"`
+	// Error is the C error code expected for invalid inputs.
+	Error string `json:"error,omitempty"`
+}
+
+var testVectors = []TestVector{
+	{Name: "epoch", Input: "1970-01-01T00:00:00Z", Valid: true, Nanos: 0, Canonical: "1970-01-01T00:00:00Z"},
+	{Name: "nanoseconds", Input: "2024-12-14T03:13:21.123456789Z", Valid: true, Nanos: 1734146001123456789, Canonical: "2024-12-14T03:13:21.123456789Z"},
+	{Name: "trailing fraction zeros", Input: "2024-12-14T12:00:00.500Z", Valid: true, Nanos: 1734177600500000000, Canonical: "2024-12-14T12:00:00.5Z"},
+	{Name: "single nanosecond", Input: "2024-12-14T12:00:00.000000001Z", Valid: true, Nanos: 1734177600000000001, Canonical: "2024-12-14T12:00:00.000000001Z"},
+	{Name: "leap day", Input: "2024-02-29T00:00:00Z", Valid: true, Nanos: 1709164800000000000, Canonical: "2024-02-29T00:00:00Z"},
+	{Name: "leap day divisible by 400", Input: "2000-02-29T12:00:00Z", Valid: true, Nanos: 951825600000000000, Canonical: "2000-02-29T12:00:00Z"},
+	{Name: "century non-leap", Input: "1900-03-01T00:00:00Z", Valid: true, Nanos: -2203891200000000000, Canonical: "1900-03-01T00:00:00Z"},
+	{Name: "last nanosecond before epoch", Input: "1969-12-31T23:59:59.999999999Z", Valid: true, Nanos: -1, Canonical: "1969-12-31T23:59:59.999999999Z"},
+	{Name: "maximum representable", Input: "2262-04-11T23:47:16.854775807Z", Valid: true, Nanos: 9223372036854775807, Canonical: "2262-04-11T23:47:16.854775807Z"},
+	{Name: "minimum representable", Input: "1677-09-21T00:12:43.145224192Z", Valid: true, Nanos: -9223372036854775808, Canonical: "1677-09-21T00:12:43.145224192Z"},
+	{Name: "missing Z", Input: "2024-12-14T12:00:00", Error: "UT_ERR_INVALID_FORMAT"},
+	{Name: "lowercase z", Input: "2024-12-14T12:00:00z", Error: "UT_ERR_INVALID_FORMAT"},
+	{Name: "lowercase t", Input: "2024-12-14t12:00:00Z", Error: "UT_ERR_INVALID_FORMAT"},
+	{Name: "space separator", Input: "2024-12-14 12:00:00Z", Error: "UT_ERR_INVALID_FORMAT"},
+	{Name: "zero offset", Input: "2024-12-14T12:00:00+00:00", Error: "UT_ERR_UNSUPPORTED_OFFSET"},
+	{Name: "non-zero offset", Input: "2024-12-14T12:00:00+01:00", Error: "UT_ERR_UNSUPPORTED_OFFSET"},
+	{Name: "empty fraction", Input: "2024-12-14T12:00:00.Z", Error: "UT_ERR_INVALID_FORMAT"},
+	{Name: "fraction too long", Input: "2024-12-14T12:00:00.1234567890Z", Error: "UT_ERR_FRACTION_TOO_LONG"},
+	{Name: "non-leap February 29", Input: "2023-02-29T00:00:00Z", Error: "UT_ERR_INVALID_DATE"},
+	{Name: "century February 29", Input: "1900-02-29T00:00:00Z", Error: "UT_ERR_INVALID_DATE"},
+	{Name: "month 13", Input: "2024-13-01T00:00:00Z", Error: "UT_ERR_INVALID_DATE"},
+	{Name: "day 0", Input: "2024-12-00T00:00:00Z", Error: "UT_ERR_INVALID_DATE"},
+	{Name: "hour 24", Input: "2024-12-14T24:00:00Z", Error: "UT_ERR_OUT_OF_RANGE"},
+	{Name: "leap second", Input: "2016-12-31T23:59:60Z", Error: "UT_ERR_OUT_OF_RANGE"},
+	{Name: "date only", Input: "2024-12-14", Error: "UT_ERR_INVALID_FORMAT"},
+	{Name: "empty string", Input: "", Error: "UT_ERR_INVALID_FORMAT"},
+	{Name: "trailing garbage", Input: "2024-12-14T12:00:00Zjunk", Error: "UT_ERR_INVALID_FORMAT"},
+	{Name: "non-digit year", Input: "20x4-12-14T12:00:00Z", Error: "UT_ERR_INVALID_FORMAT"},
+}
+
+// TestVectors returns a curated corpus of valid and invalid strict-mode
+// inputs with their expected results. The returned slice is a copy and may
+// be modified by the caller.
+func TestVectors() []TestVector {
+	out := make([]TestVector, len(testVectors))
+	copy(out, testVectors)
+	return out
+}
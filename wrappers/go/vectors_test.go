@@ -0,0 +1,52 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+)
+
+// vectorErrors maps the C error codes named by TestVector.Error to the
+// sentinel Parse wraps for them. Several codes share ErrInvalidFormat, so
+// the exact code is checked against the C core with coreParseCode.
+var vectorErrors = map[string]error{
+	"UT_ERR_INVALID_FORMAT":     ErrInvalidFormat,
+	"UT_ERR_INVALID_DATE":       ErrInvalidFormat,
+	"UT_ERR_UNSUPPORTED_OFFSET": ErrInvalidFormat,
+	"UT_ERR_FRACTION_TOO_LONG":  ErrFractionTooLong,
+	"UT_ERR_OUT_OF_RANGE":       ErrOutOfRange,
+}
+
+func TestTestVectors(t *testing.T) {
+	for _, v := range TestVectors() {
+		ts, err := Parse(v.Input)
+		if !v.Valid {
+			if code := coreParseCode(v.Input); code != v.Error {
+				t.Errorf("%s: C core code for %q = %s, expected %s", v.Name, v.Input, code, v.Error)
+			}
+			expected, ok := vectorErrors[v.Error]
+			switch {
+			case !ok:
+				t.Errorf("%s: unknown error code %s", v.Name, v.Error)
+			case err == nil:
+				t.Errorf("%s: Parse(%q) succeeded, expected %s", v.Name, v.Input, v.Error)
+			case !errors.Is(err, expected):
+				t.Errorf("%s: Parse(%q) = %v, expected %v", v.Name, v.Input, err, expected)
+			}
+			continue
+		}
+
+		if code := coreParseCode(v.Input); code != "UT_OK" {
+			t.Errorf("%s: C core code for %q = %s, expected UT_OK", v.Name, v.Input, code)
+		}
+		if err != nil {
+			t.Errorf("%s: Parse(%q) failed: %v", v.Name, v.Input, err)
+			continue
+		}
+		if int64(ts) != v.Nanos {
+			t.Errorf("%s: Parse(%q) = %d, expected %d", v.Name, v.Input, int64(ts), v.Nanos)
+		}
+		if got := ts.Format(); got != v.Canonical {
+			t.Errorf("%s: Format() = %q, expected %q", v.Name, got, v.Canonical)
+		}
+	}
+}
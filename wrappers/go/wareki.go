@@ -0,0 +1,290 @@
+package universal_timestamp
+
+/*
+#include "universal_timestamp.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JapaneseEra is a Japanese imperial era (gengō), as numbered by the C
+// core.
+type JapaneseEra int
+
+const (
+	// EraReiwa began on 2019-05-01.
+	EraReiwa JapaneseEra = C.UT_ERA_REIWA
+	// EraHeisei ran from 1989-01-08 to 2019-04-30.
+	EraHeisei JapaneseEra = C.UT_ERA_HEISEI
+	// EraShowa ran from 1926-12-25 to 1989-01-07.
+	EraShowa JapaneseEra = C.UT_ERA_SHOWA
+	// EraTaisho ran from 1912-07-30 to 1926-12-24.
+	EraTaisho JapaneseEra = C.UT_ERA_TAISHO
+	// EraMeiji ran from 1868-01-25 to 1912-07-29.
+	EraMeiji JapaneseEra = C.UT_ERA_MEIJI
+)
+
+// japaneseEras is the era transition table, newest first and indexed by
+// JapaneseEra. It mirrors JAPANESE_ERAS in the C core, adding the kanji
+// and letter forms the core lacks, so that dates can be taken in any zone;
+// the wareki tests check it against the core with coreJapaneseEra.
+var japaneseEras = [...]struct {
+	year   int
+	month  time.Month
+	day    int
+	name   string
+	kanji  string
+	letter byte
+}{
+	EraReiwa:  {2019, time.May, 1, "Reiwa", "令和", 'R'},
+	EraHeisei: {1989, time.January, 8, "Heisei", "平成", 'H'},
+	EraShowa:  {1926, time.December, 25, "Showa", "昭和", 'S'},
+	EraTaisho: {1912, time.July, 30, "Taisho", "大正", 'T'},
+	EraMeiji:  {1868, time.January, 25, "Meiji", "明治", 'M'},
+}
+
+// String returns the romanized name of the era, such as "Reiwa".
+func (e JapaneseEra) String() string {
+	if e.valid() {
+		return japaneseEras[e].name
+	}
+	return "JapaneseEra(" + strconv.Itoa(int(e)) + ")"
+}
+
+// Kanji returns the era's name in kanji, such as "令和".
+func (e JapaneseEra) Kanji() string {
+	if e.valid() {
+		return japaneseEras[e].kanji
+	}
+	return ""
+}
+
+// Start returns the Gregorian date on which the era began.
+func (e JapaneseEra) Start() (year int, month time.Month, day int) {
+	if !e.valid() {
+		return 0, 0, 0
+	}
+	era := japaneseEras[e]
+	return era.year, era.month, era.day
+}
+
+func (e JapaneseEra) valid() bool {
+	return e >= 0 && int(e) < len(japaneseEras)
+}
+
+// coreJapaneseEra returns the era and era year the C core assigns to the
+// UTC date of ts. ok is false if the core reports the date out of range.
+func coreJapaneseEra(ts Timestamp) (era JapaneseEra, year int, ok bool) {
+	var e C.ut_japanese_era_t
+	var y C.int
+	observeCgo("ut_to_japanese_era")
+	if C.ut_to_japanese_era(C.ut_timestamp_t{nanos: C.long(ts)}, &e, &y) != C.UT_OK {
+		return 0, 0, false
+	}
+	return JapaneseEra(e), int(y), true
+}
+
+// coreJapaneseEraName returns the C core's romanized name of e.
+func coreJapaneseEraName(e JapaneseEra) string {
+	observeCgo("ut_japanese_era_name")
+	return C.GoString(C.ut_japanese_era_name(C.ut_japanese_era_t(e)))
+}
+
+// JapaneseDate is a calendar date in the Japanese era system (wareki),
+// such as 令和6年12月14日. Year counts from 1 in the era's first year.
+type JapaneseDate struct {
+	Era   JapaneseEra
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// JapaneseDateOf returns the wareki date of ts as observed in loc, which
+// for Japanese documents is usually Asia/Tokyo. A nil loc is treated as
+// UTC. It returns ErrOutOfRange for dates before the Meiji era.
+func JapaneseDateOf(ts Timestamp, loc *time.Location) (JapaneseDate, error) {
+	y, m, d := ts.In(loc).Date()
+	for e, era := range japaneseEras {
+		if y > era.year || y == era.year && (m > era.month || m == era.month && d >= era.day) {
+			return JapaneseDate{Era: JapaneseEra(e), Year: y - era.year + 1, Month: m, Day: d}, nil
+		}
+	}
+	return JapaneseDate{}, fmt.Errorf("%w: %04d-%02d-%02d is before the Meiji era", ErrOutOfRange, y, m, d)
+}
+
+// FormatWareki formats the date of t in loc as, for example,
+// "令和6年12月14日", writing the first year of an era as 元年.
+func (t Timestamp) FormatWareki(loc *time.Location) (string, error) {
+	d, err := JapaneseDateOf(t, loc)
+	if err != nil {
+		return "", err
+	}
+	return d.String(), nil
+}
+
+// String formats the date as "令和6年12月14日", writing the first year of
+// an era as 元年.
+func (d JapaneseDate) String() string {
+	year := strconv.Itoa(d.Year)
+	if d.Year == 1 {
+		year = "元"
+	}
+	return d.Era.Kanji() + year + "年" + strconv.Itoa(int(d.Month)) + "月" + strconv.Itoa(d.Day) + "日"
+}
+
+// GregorianYear returns the Gregorian year of the date.
+func (d JapaneseDate) GregorianYear() int {
+	year, _, _ := d.Era.Start()
+	return year + d.Year - 1
+}
+
+// IsValid reports whether the date exists and falls within its era.
+func (d JapaneseDate) IsValid() bool {
+	if !d.Era.valid() || d.Year < 1 || d.Month < time.January || d.Month > time.December {
+		return false
+	}
+	y := d.GregorianYear()
+	if d.Day < 1 || d.Day > DaysInMonth(y, d.Month) {
+		return false
+	}
+	// The date must be on or after the era's start and before the start
+	// of the following era, which precedes it in the table.
+	start := japaneseEras[d.Era]
+	if y == start.year && (d.Month < start.month || d.Month == start.month && d.Day < start.day) {
+		return false
+	}
+	if d.Era > 0 {
+		next := japaneseEras[d.Era-1]
+		if y > next.year || y == next.year && (d.Month > next.month || d.Month == next.month && d.Day >= next.day) {
+			return false
+		}
+	}
+	return true
+}
+
+// Start returns the first instant of the date in loc. A nil loc is treated
+// as UTC. It returns ErrOutOfRange if the date does not fall within its
+// era, such as 平成31年5月1日.
+func (d JapaneseDate) Start(loc *time.Location) (Timestamp, error) {
+	if !d.IsValid() {
+		return 0, fmt.Errorf("%w: %s", ErrOutOfRange, d)
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return FromTime(time.Date(d.GregorianYear(), d.Month, d.Day, 0, 0, 0, 0, loc)), nil
+}
+
+// ParseJapaneseDate parses a wareki date. The era may be written in kanji
+// (令和), romanized (Reiwa, case-insensitive, optionally followed by a
+// space) or as its initial letter (R). Year, month and day follow as
+// "6年12月14日" or "6.12.14"; the first year may be written 元, and
+// full-width digits are accepted. It returns ErrInvalidFormat for
+// malformed input and ErrOutOfRange for dates outside their era.
+func ParseJapaneseDate(s string) (JapaneseDate, error) {
+	rest := normalizeWidth(strings.TrimSpace(s))
+	era, rest, ok := cutJapaneseEra(rest)
+	if !ok {
+		return JapaneseDate{}, fmt.Errorf("%w: unknown era in %q", ErrInvalidFormat, s)
+	}
+
+	var year int
+	if strings.HasPrefix(rest, "元") {
+		year, rest = 1, rest[len("元"):]
+	} else if year, rest, ok = cutNumber(rest); !ok {
+		return JapaneseDate{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+
+	var month, day int
+	ok = false
+	if r, found := strings.CutPrefix(rest, "年"); found {
+		month, day, ok = cutMonthDay(r, "月", "日")
+	} else if r, found := strings.CutPrefix(rest, "."); found {
+		month, day, ok = cutMonthDay(r, ".", "")
+	}
+	if !ok {
+		return JapaneseDate{}, fmt.Errorf("%w: %q", ErrInvalidFormat, s)
+	}
+
+	d := JapaneseDate{Era: era, Year: year, Month: time.Month(month), Day: day}
+	if !d.IsValid() {
+		return JapaneseDate{}, fmt.Errorf("%w: %q", ErrOutOfRange, s)
+	}
+	return d, nil
+}
+
+// ParseWareki parses a wareki date, as accepted by ParseJapaneseDate, and
+// returns the first instant of that day in loc. A nil loc is treated as
+// UTC.
+func ParseWareki(s string, loc *time.Location) (Timestamp, error) {
+	d, err := ParseJapaneseDate(s)
+	if err != nil {
+		return 0, err
+	}
+	return d.Start(loc)
+}
+
+// cutJapaneseEra removes a leading era name from s.
+func cutJapaneseEra(s string) (JapaneseEra, string, bool) {
+	for e, era := range japaneseEras {
+		if rest, ok := strings.CutPrefix(s, era.kanji); ok {
+			return JapaneseEra(e), rest, true
+		}
+		if len(s) >= len(era.name) && strings.EqualFold(s[:len(era.name)], era.name) {
+			return JapaneseEra(e), strings.TrimPrefix(s[len(era.name):], " "), true
+		}
+	}
+	for e, era := range japaneseEras {
+		if len(s) > 1 && (s[0] == era.letter || s[0] == era.letter+'a'-'A') && isDigit(s[1]) {
+			return JapaneseEra(e), s[1:], true
+		}
+	}
+	return 0, s, false
+}
+
+// cutMonthDay reads the rest of a wareki date after the year: a month,
+// sep, a day and end, with nothing following.
+func cutMonthDay(s, sep, end string) (month, day int, ok bool) {
+	month, s, ok = cutNumber(s)
+	if ok {
+		s, ok = strings.CutPrefix(s, sep)
+	}
+	if ok {
+		day, s, ok = cutNumber(s)
+	}
+	if ok {
+		s, ok = strings.CutPrefix(s, end)
+	}
+	return month, day, ok && s == ""
+}
+
+// cutNumber removes a leading run of at most four ASCII digits from s.
+func cutNumber(s string) (int, string, bool) {
+	n, i := 0, 0
+	for i < len(s) && i < 4 && isDigit(s[i]) {
+		n = n*10 + int(s[i]-'0')
+		i++
+	}
+	return n, s[i:], i > 0
+}
+
+// normalizeWidth replaces full-width digits, the full stop and the ideographic
+// space with their ASCII forms.
+func normalizeWidth(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '０' && r <= '９':
+			return '0' + (r - '０')
+		case r == '．':
+			return '.'
+		case r == '　':
+			return ' '
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,119 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestJapaneseDateOf(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"2024-12-14T12:00:00Z", "令和6年12月14日"},
+		{"2019-05-01T00:00:00Z", "令和元年5月1日"},
+		{"2019-04-30T23:59:59Z", "平成31年4月30日"},
+		{"1989-01-07T00:00:00Z", "昭和64年1月7日"},
+		{"1989-01-08T00:00:00Z", "平成元年1月8日"},
+		{"1926-12-25T00:00:00Z", "昭和元年12月25日"},
+		{"1912-07-29T00:00:00Z", "明治45年7月29日"},
+		{"1912-07-30T00:00:00Z", "大正元年7月30日"},
+	}
+	for _, tt := range tests {
+		got, err := mustParse(t, tt.input).FormatWareki(nil)
+		if err != nil || got != tt.expected {
+			t.Errorf("FormatWareki(%s) = %s, %v, expected %s", tt.input, got, err, tt.expected)
+		}
+	}
+
+	if _, err := JapaneseDateOf(mustParse(t, "1868-01-24T00:00:00Z"), nil); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("JapaneseDateOf before Meiji error = %v, expected ErrOutOfRange", err)
+	}
+}
+
+func TestJapaneseDateOfZone(t *testing.T) {
+	jst := time.FixedZone("JST", 9*3600)
+	d, err := JapaneseDateOf(mustParse(t, "2019-04-30T15:00:00Z"), jst)
+	if err != nil || d != (JapaneseDate{EraReiwa, 1, time.May, 1}) {
+		t.Errorf("JapaneseDateOf(JST) = %+v, %v", d, err)
+	}
+}
+
+func TestParseJapaneseDate(t *testing.T) {
+	expected := JapaneseDate{EraReiwa, 6, time.December, 14}
+	for _, s := range []string{
+		"令和6年12月14日",
+		"令和６年１２月１４日",
+		"Reiwa 6年12月14日",
+		"reiwa6.12.14",
+		"R6.12.14",
+		"r６．１２．１４",
+	} {
+		if d, err := ParseJapaneseDate(s); err != nil || d != expected {
+			t.Errorf("ParseJapaneseDate(%q) = %+v, %v", s, d, err)
+		}
+	}
+
+	if d, err := ParseJapaneseDate("平成元年1月8日"); err != nil || d != (JapaneseDate{EraHeisei, 1, time.January, 8}) {
+		t.Errorf("ParseJapaneseDate(元年) = %+v, %v", d, err)
+	}
+
+	invalid := map[string]error{
+		"令和6年12月":     ErrInvalidFormat,
+		"令和6年12月14":   ErrInvalidFormat,
+		"X6.12.14":    ErrInvalidFormat,
+		"令和6年12月14日x": ErrInvalidFormat,
+		"R6/12/14":    ErrInvalidFormat,
+		"平成31年5月1日":   ErrOutOfRange,
+		"令和元年4月30日":   ErrOutOfRange,
+		"令和6年2月30日":   ErrOutOfRange,
+		"令和0年1月1日":    ErrOutOfRange,
+	}
+	for s, want := range invalid {
+		if _, err := ParseJapaneseDate(s); !errors.Is(err, want) {
+			t.Errorf("ParseJapaneseDate(%q) error = %v, expected %v", s, err, want)
+		}
+	}
+}
+
+func TestParseWareki(t *testing.T) {
+	ts, err := ParseWareki("令和6年12月14日", time.FixedZone("JST", 9*3600))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ts.Format(); got != "2024-12-13T15:00:00Z" {
+		t.Errorf("ParseWareki() = %s, expected 2024-12-13T15:00:00Z", got)
+	}
+}
+
+func TestJapaneseEra(t *testing.T) {
+	if EraHeisei.String() != "Heisei" || EraHeisei.Kanji() != "平成" {
+		t.Errorf("EraHeisei = %s %s", EraHeisei, EraHeisei.Kanji())
+	}
+	if y, m, d := EraShowa.Start(); y != 1926 || m != time.December || d != 25 {
+		t.Errorf("EraShowa.Start() = %d-%d-%d", y, m, d)
+	}
+	if s := JapaneseEra(9).String(); s != "JapaneseEra(9)" {
+		t.Errorf("JapaneseEra(9).String() = %s", s)
+	}
+}
+
+func TestJapaneseErasMatchCore(t *testing.T) {
+	for e := range japaneseEras {
+		era := JapaneseEra(e)
+		if got := coreJapaneseEraName(era); got != era.String() {
+			t.Errorf("C core name of %s = %q", era, got)
+		}
+
+		y, m, d := era.Start()
+		start := FromTime(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+		for _, ts := range []Timestamp{start - 1, start, start + Timestamp(Day)} {
+			core, coreYear, ok := coreJapaneseEra(ts)
+			date, err := JapaneseDateOf(ts, nil)
+			if ok != (err == nil) || ok && (core != date.Era || coreYear != date.Year) {
+				t.Errorf("%s: C core = %s %d (%v), Go = %s %d (%v)", ts.Format(), core, coreYear, ok, date.Era, date.Year, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package universal_timestamp
+
+import "sync"
+
+// Watermark tracks the low watermark of a stream split across partitions:
+// the latest event time up to which every partition has progressed, less
+// an allowed lateness. Windows ending at or before the watermark are safe
+// to close. The watermark never moves backwards. It is safe for concurrent
+// use.
+type Watermark struct {
+	mu         sync.Mutex
+	lateness   Duration
+	partitions map[string]Timestamp
+	seen       map[string]bool
+	current    Timestamp
+	valid      bool
+}
+
+// NewWatermark returns a Watermark allowing events up to lateness behind
+// the slowest partition. The watermark is not defined until each of the
+// given partitions has reported an event; partitions not listed are added
+// on their first Observe.
+func NewWatermark(lateness Duration, partitions ...string) *Watermark {
+	w := &Watermark{
+		lateness:   lateness,
+		partitions: make(map[string]Timestamp, len(partitions)),
+		seen:       make(map[string]bool, len(partitions)),
+	}
+	for _, p := range partitions {
+		w.partitions[p] = 0
+	}
+	return w
+}
+
+// Observe records an event time from partition. It returns the watermark
+// and whether the watermark advanced as a result.
+func (w *Watermark) Observe(partition string, ts Timestamp) (Timestamp, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.seen[partition] || ts > w.partitions[partition] {
+		w.partitions[partition] = ts
+		w.seen[partition] = true
+	}
+	return w.recompute()
+}
+
+// Remove stops tracking partition, for example when it becomes idle, so it
+// no longer holds the watermark back. It returns the watermark and whether
+// it advanced.
+func (w *Watermark) Remove(partition string) (Timestamp, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.partitions, partition)
+	delete(w.seen, partition)
+	return w.recompute()
+}
+
+// Current returns the watermark, and false if it is not yet defined.
+func (w *Watermark) Current() (Timestamp, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.current, w.valid
+}
+
+// IsLate reports whether an event at ts arrives after the watermark has
+// passed it and should be treated as late data.
+func (w *Watermark) IsLate(ts Timestamp) bool {
+	wm, ok := w.Current()
+	return ok && ts < wm
+}
+
+// recompute updates the watermark from the slowest partition. The caller
+// must hold w.mu.
+func (w *Watermark) recompute() (Timestamp, bool) {
+	if len(w.partitions) == 0 {
+		return w.current, false
+	}
+
+	first := true
+	var low Timestamp
+	for p, ts := range w.partitions {
+		if !w.seen[p] {
+			return w.current, false
+		}
+		if first || ts < low {
+			low = ts
+			first = false
+		}
+	}
+
+	wm := low - Timestamp(w.lateness)
+	if w.valid && wm <= w.current {
+		return w.current, false
+	}
+	w.current = wm
+	w.valid = true
+	return wm, true
+}
@@ -0,0 +1,44 @@
+package universal_timestamp
+
+import "testing"
+
+func TestWatermark(t *testing.T) {
+	base := mustParse(t, "2024-12-14T12:00:00Z")
+	at := func(s int64) Timestamp { return base + Timestamp(s)*Timestamp(Second) }
+
+	w := NewWatermark(5*Second, "a", "b")
+	if _, ok := w.Current(); ok {
+		t.Fatal("watermark defined before any events")
+	}
+
+	if _, advanced := w.Observe("a", at(10)); advanced {
+		t.Error("watermark advanced before partition b reported")
+	}
+	wm, advanced := w.Observe("b", at(20))
+	if !advanced || wm != at(5) {
+		t.Errorf("Observe() = %s, %v; expected %s, true", wm.Format(), advanced, at(5).Format())
+	}
+
+	// An out-of-order event on a partition does not move it backwards.
+	if wm, advanced := w.Observe("a", at(8)); advanced || wm != at(5) {
+		t.Errorf("late event moved watermark to %s", wm.Format())
+	}
+	if !w.IsLate(at(4)) || w.IsLate(at(5)) {
+		t.Error("IsLate() results wrong")
+	}
+
+	if wm, advanced := w.Observe("a", at(30)); !advanced || wm != at(15) {
+		t.Errorf("Observe() = %s, expected %s", wm.Format(), at(15).Format())
+	}
+
+	// A new partition cannot pull the watermark back.
+	if wm, advanced := w.Observe("c", at(0)); advanced || wm != at(15) {
+		t.Errorf("new partition moved watermark to %s", wm.Format())
+	}
+	if wm, advanced := w.Remove("c"); advanced || wm != at(15) {
+		t.Errorf("Remove() = %s, %v", wm.Format(), advanced)
+	}
+	if wm, advanced := w.Remove("b"); !advanced || wm != at(25) {
+		t.Errorf("Remove() = %s, %v; expected %s", wm.Format(), advanced, at(25).Format())
+	}
+}
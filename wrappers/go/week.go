@@ -0,0 +1,81 @@
+package universal_timestamp
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// DefaultWeekStart is the first day of the week used by StartOfWeek and the
+// other week helpers until SetWeekStart is called. It matches ISO 8601.
+const DefaultWeekStart = time.Monday
+
+// weekStart holds the configured first day of the week.
+var weekStart atomic.Int32
+
+func init() {
+	weekStart.Store(int32(DefaultWeekStart))
+}
+
+// SetWeekStart sets the first day of the week used by StartOfWeek,
+// EndOfWeek, WeekdayIndex, WeekOfYear, WeeklyHistogram and
+// NewWeeklyWindower: time.Monday in most of Europe,
+// time.Sunday in the United States and time.Saturday in much of the Middle
+// East. A value outside time.Sunday to time.Saturday restores
+// DefaultWeekStart.
+func SetWeekStart(day time.Weekday) {
+	if day < time.Sunday || day > time.Saturday {
+		day = DefaultWeekStart
+	}
+	weekStart.Store(int32(day))
+}
+
+// WeekStart returns the first day of the week currently in use.
+func WeekStart() time.Weekday {
+	return time.Weekday(weekStart.Load())
+}
+
+// WeekdayIndex returns the number of days (0-6) since the start of the week
+// containing the timestamp in loc, so the first day of the week is 0 and
+// the last is 6. A nil loc is treated as UTC.
+func (t Timestamp) WeekdayIndex(loc *time.Location) int {
+	return weekdayIndex(t.In(loc).Weekday(), WeekStart())
+}
+
+// StartOfWeek returns midnight at the start of the week containing the
+// timestamp, as observed in loc. If midnight does not exist because of a
+// daylight-saving transition, the first instant of the day is returned. A
+// nil loc is treated as UTC.
+func (t Timestamp) StartOfWeek(loc *time.Location) Timestamp {
+	return t.weekBoundary(0, loc)
+}
+
+// EndOfWeek returns the last nanosecond of the week containing the
+// timestamp, as observed in loc. A nil loc is treated as UTC.
+func (t Timestamp) EndOfWeek(loc *time.Location) Timestamp {
+	return t.weekBoundary(1, loc) - 1
+}
+
+// weekBoundary returns the start of the week n weeks after the one
+// containing t in loc. Each boundary is the start of its own day, so a
+// daylight-saving gap at one week's midnight does not shift the others.
+func (t Timestamp) weekBoundary(n int, loc *time.Location) Timestamp {
+	lt := t.In(loc)
+	day := lt.Day() - weekdayIndex(lt.Weekday(), WeekStart()) + 7*n
+	return StartOfDate(lt.Year(), lt.Month(), day, loc)
+}
+
+// WeekOfYear returns the week number of the timestamp in loc, counting the
+// week that contains January 1 as week 1, so a week spanning the new year
+// is both the last week of one year and week 1 of the next. Week
+// boundaries follow WeekStart; for ISO 8601 week numbers, use
+// time.Time.ISOWeek. A nil loc is treated as UTC.
+func (t Timestamp) WeekOfYear(loc *time.Location) int {
+	lt := t.In(loc)
+	jan1 := time.Date(lt.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	return (lt.YearDay()-1+weekdayIndex(jan1.Weekday(), WeekStart()))/7 + 1
+}
+
+// weekdayIndex returns the number of days from start to wd, going forward.
+func weekdayIndex(wd, start time.Weekday) int {
+	return (int(wd) - int(start) + 7) % 7
+}
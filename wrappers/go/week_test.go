@@ -0,0 +1,105 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekStart(t *testing.T) {
+	defer SetWeekStart(DefaultWeekStart)
+
+	// 2024-12-14 is a Saturday.
+	ts := mustParse(t, "2024-12-14T15:30:00Z")
+	cases := []struct {
+		start time.Weekday
+		index int
+		begin string
+		end   string
+		week  int
+	}{
+		{time.Monday, 5, "2024-12-09T00:00:00Z", "2024-12-15T23:59:59.999999999Z", 50},
+		{time.Sunday, 6, "2024-12-08T00:00:00Z", "2024-12-14T23:59:59.999999999Z", 50},
+		{time.Saturday, 0, "2024-12-14T00:00:00Z", "2024-12-20T23:59:59.999999999Z", 51},
+	}
+	for _, c := range cases {
+		SetWeekStart(c.start)
+		if got := WeekStart(); got != c.start {
+			t.Errorf("WeekStart() = %s, expected %s", got, c.start)
+		}
+		if got := ts.WeekdayIndex(nil); got != c.index {
+			t.Errorf("%s: WeekdayIndex() = %d, expected %d", c.start, got, c.index)
+		}
+		if got := ts.StartOfWeek(nil).Format(); got != c.begin {
+			t.Errorf("%s: StartOfWeek() = %s, expected %s", c.start, got, c.begin)
+		}
+		if got := ts.EndOfWeek(nil).Format(); got != c.end {
+			t.Errorf("%s: EndOfWeek() = %s, expected %s", c.start, got, c.end)
+		}
+		if got := ts.WeekOfYear(nil); got != c.week {
+			t.Errorf("%s: WeekOfYear() = %d, expected %d", c.start, got, c.week)
+		}
+	}
+
+	SetWeekStart(time.Weekday(9))
+	if got := WeekStart(); got != DefaultWeekStart {
+		t.Errorf("WeekStart() after invalid value = %s, expected %s", got, DefaultWeekStart)
+	}
+}
+
+func TestWeekOfYearBoundary(t *testing.T) {
+	defer SetWeekStart(DefaultWeekStart)
+	SetWeekStart(time.Sunday)
+
+	// 2022-01-01 is a Saturday, so week 2 begins the next day.
+	if got := mustParse(t, "2022-01-01T12:00:00Z").WeekOfYear(nil); got != 1 {
+		t.Errorf("WeekOfYear(2022-01-01) = %d, expected 1", got)
+	}
+	if got := mustParse(t, "2022-01-02T12:00:00Z").WeekOfYear(nil); got != 2 {
+		t.Errorf("WeekOfYear(2022-01-02) = %d, expected 2", got)
+	}
+	start := mustParse(t, "2022-01-01T12:00:00Z").StartOfWeek(nil).Format()
+	if start != "2021-12-26T00:00:00Z" {
+		t.Errorf("StartOfWeek(2022-01-01) = %s, expected 2021-12-26T00:00:00Z", start)
+	}
+}
+
+func TestStartOfWeekZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	defer SetWeekStart(DefaultWeekStart)
+	SetWeekStart(time.Sunday)
+
+	// Sunday 03:00 UTC is still Saturday evening in New York.
+	ts := mustParse(t, "2024-03-10T03:00:00Z")
+	if got := ts.StartOfWeek(loc).Format(); got != "2024-03-03T05:00:00Z" {
+		t.Errorf("StartOfWeek(New_York) = %s, expected 2024-03-03T05:00:00Z", got)
+	}
+	// The week containing the spring-forward transition is an hour short.
+	if got := ts.EndOfWeek(loc).Format(); got != "2024-03-10T04:59:59.999999999Z" {
+		t.Errorf("EndOfWeek(New_York) = %s, expected 2024-03-10T04:59:59.999999999Z", got)
+	}
+}
+
+func TestStartOfWeekMidnightGap(t *testing.T) {
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+	defer SetWeekStart(DefaultWeekStart)
+	SetWeekStart(time.Sunday)
+
+	// Sunday 2015-10-18 began at 01:00 local, 03:00 UTC, when Sao Paulo
+	// skipped midnight.
+	if got := mustParse(t, "2015-10-20T12:00:00Z").StartOfWeek(loc).Format(); got != "2015-10-18T03:00:00Z" {
+		t.Errorf("StartOfWeek(Sao_Paulo) = %s, expected 2015-10-18T03:00:00Z", got)
+	}
+	if got := mustParse(t, "2015-10-14T12:00:00Z").EndOfWeek(loc).Format(); got != "2015-10-18T02:59:59.999999999Z" {
+		t.Errorf("EndOfWeek(Sao_Paulo) = %s, expected 2015-10-18T02:59:59.999999999Z", got)
+	}
+	// The following week starts at an ordinary midnight, now at -02:00.
+	if got := mustParse(t, "2015-10-20T12:00:00Z").EndOfWeek(loc).Format(); got != "2015-10-25T01:59:59.999999999Z" {
+		t.Errorf("EndOfWeek(Sao_Paulo) after the gap = %s, expected 2015-10-25T01:59:59.999999999Z", got)
+	}
+}
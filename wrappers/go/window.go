@@ -0,0 +1,141 @@
+package universal_timestamp
+
+import (
+	"errors"
+	"sort"
+)
+
+// WindowKind identifies the windowing strategy used by a Windower.
+type WindowKind int
+
+const (
+	// TumblingWindow assigns each timestamp to exactly one fixed-size,
+	// non-overlapping window.
+	TumblingWindow WindowKind = iota
+	// SlidingWindow assigns each timestamp to every fixed-size window that
+	// contains it, with a new window starting every slide.
+	SlidingWindow
+	// SessionWindow groups timestamps into windows separated by periods of
+	// inactivity longer than the gap.
+	SessionWindow
+)
+
+// Windower assigns timestamps to tumbling, sliding, or session windows.
+// Fixed-size windows are aligned to Origin, which defaults to the Unix epoch.
+type Windower struct {
+	Kind   WindowKind
+	Size   Duration
+	Slide  Duration
+	Gap    Duration
+	Origin Timestamp
+}
+
+// NewTumblingWindower returns a Windower producing non-overlapping windows of
+// the given size.
+func NewTumblingWindower(size Duration) (*Windower, error) {
+	if size <= 0 {
+		return nil, errors.New("window size must be positive")
+	}
+	return &Windower{Kind: TumblingWindow, Size: size, Slide: size}, nil
+}
+
+// NewWeeklyWindower returns a Windower producing calendar weeks in UTC,
+// each beginning at midnight on the WeekStart day in effect when it is
+// called. Use WeeklyHistogram for weeks in another zone.
+func NewWeeklyWindower() *Windower {
+	return &Windower{Kind: TumblingWindow, Size: 7 * Day, Slide: 7 * Day, Origin: Timestamp(0).StartOfWeek(nil)}
+}
+
+// NewSlidingWindower returns a Windower producing windows of the given size
+// that start every slide.
+func NewSlidingWindower(size, slide Duration) (*Windower, error) {
+	if size <= 0 || slide <= 0 {
+		return nil, errors.New("window size and slide must be positive")
+	}
+	return &Windower{Kind: SlidingWindow, Size: size, Slide: slide}, nil
+}
+
+// NewSessionWindower returns a Windower that closes a session once no
+// timestamp has been seen for gap.
+func NewSessionWindower(gap Duration) (*Windower, error) {
+	if gap <= 0 {
+		return nil, errors.New("session gap must be positive")
+	}
+	return &Windower{Kind: SessionWindow, Gap: gap}, nil
+}
+
+// Assign returns the windows containing ts, ordered by start time.
+// For session windows it returns the provisional window [ts, ts+Gap),
+// which Windows merges with its neighbours.
+func (w *Windower) Assign(ts Timestamp) []Interval {
+	switch w.Kind {
+	case SessionWindow:
+		return []Interval{{Start: ts, End: ts + Timestamp(w.Gap)}}
+	case SlidingWindow:
+		slide := int64(w.Slide)
+		size := int64(w.Size)
+		offset := int64(ts - w.Origin)
+		last := floorDiv(offset, slide) * slide
+		var windows []Interval
+		for start := last; start > offset-size; start -= slide {
+			s := w.Origin + Timestamp(start)
+			windows = append(windows, Interval{Start: s, End: s + Timestamp(size)})
+		}
+		for i, j := 0, len(windows)-1; i < j; i, j = i+1, j-1 {
+			windows[i], windows[j] = windows[j], windows[i]
+		}
+		return windows
+	default:
+		size := int64(w.Size)
+		start := w.Origin + Timestamp(floorDiv(int64(ts-w.Origin), size)*size)
+		return []Interval{{Start: start, End: start + Timestamp(size)}}
+	}
+}
+
+// Windows returns the distinct windows covering all of the given timestamps,
+// ordered by start time. Overlapping session windows are merged.
+func (w *Windower) Windows(ts []Timestamp) []Interval {
+	seen := make(map[Interval]bool)
+	var windows []Interval
+	for _, t := range ts {
+		for _, iv := range w.Assign(t) {
+			if !seen[iv] {
+				seen[iv] = true
+				windows = append(windows, iv)
+			}
+		}
+	}
+
+	sort.Slice(windows, func(i, j int) bool {
+		if windows[i].Start != windows[j].Start {
+			return windows[i].Start < windows[j].Start
+		}
+		return windows[i].End < windows[j].End
+	})
+
+	if w.Kind != SessionWindow || len(windows) == 0 {
+		return windows
+	}
+
+	merged := windows[:1]
+	for _, iv := range windows[1:] {
+		last := &merged[len(merged)-1]
+		if iv.Start <= last.End {
+			if iv.End > last.End {
+				last.End = iv.End
+			}
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged
+}
+
+// floorDiv divides a by b, rounding towards negative infinity.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
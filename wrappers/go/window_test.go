@@ -0,0 +1,90 @@
+package universal_timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTumblingWindow(t *testing.T) {
+	w, err := NewTumblingWindower(Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := w.Assign(mustParse(t, "2024-12-14T12:34:56Z"))
+	if len(got) != 1 || got[0].String() != "2024-12-14T12:00:00Z/2024-12-14T13:00:00Z" {
+		t.Errorf("Assign() = %v", got)
+	}
+
+	got = w.Assign(mustParse(t, "1969-12-31T23:30:00Z"))
+	if got[0].String() != "1969-12-31T23:00:00Z/1970-01-01T00:00:00Z" {
+		t.Errorf("Assign() before epoch = %v", got)
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	w, err := NewSlidingWindower(10*Minute, 5*Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := w.Assign(mustParse(t, "2024-12-14T12:07:00Z"))
+	if len(got) != 2 {
+		t.Fatalf("expected 2 windows, got %v", got)
+	}
+	if got[0].String() != "2024-12-14T12:00:00Z/2024-12-14T12:10:00Z" ||
+		got[1].String() != "2024-12-14T12:05:00Z/2024-12-14T12:15:00Z" {
+		t.Errorf("Assign() = %v", got)
+	}
+}
+
+func TestSessionWindow(t *testing.T) {
+	w, err := NewSessionWindower(5 * Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := []Timestamp{
+		mustParse(t, "2024-12-14T12:00:00Z"),
+		mustParse(t, "2024-12-14T12:03:00Z"),
+		mustParse(t, "2024-12-14T12:20:00Z"),
+	}
+	got := w.Windows(ts)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 sessions, got %v", got)
+	}
+	if got[0].String() != "2024-12-14T12:00:00Z/2024-12-14T12:08:00Z" {
+		t.Errorf("first session = %v", got[0])
+	}
+}
+
+func TestWindowerInvalid(t *testing.T) {
+	if _, err := NewTumblingWindower(0); err == nil {
+		t.Error("expected error for zero size")
+	}
+	if _, err := NewSlidingWindower(Minute, 0); err == nil {
+		t.Error("expected error for zero slide")
+	}
+	if _, err := NewSessionWindower(-Second); err == nil {
+		t.Error("expected error for negative gap")
+	}
+}
+
+func TestWeeklyWindower(t *testing.T) {
+	defer SetWeekStart(DefaultWeekStart)
+
+	// 2024-12-14 is a Saturday.
+	ts := mustParse(t, "2024-12-14T12:00:00Z")
+	expected := map[time.Weekday]string{
+		time.Monday:   "2024-12-09T00:00:00Z/2024-12-16T00:00:00Z",
+		time.Sunday:   "2024-12-08T00:00:00Z/2024-12-15T00:00:00Z",
+		time.Saturday: "2024-12-14T00:00:00Z/2024-12-21T00:00:00Z",
+	}
+	for start, want := range expected {
+		SetWeekStart(start)
+		got := NewWeeklyWindower().Assign(ts)
+		if len(got) != 1 || got[0].String() != want {
+			t.Errorf("weekly window starting %s = %v, expected %s", start, got, want)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package universal_timestamp
+
+import "strings"
+
+// defaultZoneAbbreviations maps common time zone abbreviations to UTC
+// offsets in seconds. Abbreviations shared by several zones resolve to
+// the most widely used one: CST is US Central, IST is India and BST is
+// British Summer Time.
+var defaultZoneAbbreviations = map[string]int{
+	"UTC":  0,
+	"GMT":  0,
+	"UT":   0,
+	"WET":  0,
+	"WEST": 1 * 3600,
+	"BST":  1 * 3600,
+	"CET":  1 * 3600,
+	"CEST": 2 * 3600,
+	"EET":  2 * 3600,
+	"EEST": 3 * 3600,
+	"MSK":  3 * 3600,
+	"IST":  5*3600 + 1800,
+	"SGT":  8 * 3600,
+	"HKT":  8 * 3600,
+	"AWST": 8 * 3600,
+	"JST":  9 * 3600,
+	"KST":  9 * 3600,
+	"ACST": 9*3600 + 1800,
+	"AEST": 10 * 3600,
+	"AEDT": 11 * 3600,
+	"NZST": 12 * 3600,
+	"NZDT": 13 * 3600,
+	"HST":  -10 * 3600,
+	"AKST": -9 * 3600,
+	"AKDT": -8 * 3600,
+	"PST":  -8 * 3600,
+	"PDT":  -7 * 3600,
+	"MST":  -7 * 3600,
+	"MDT":  -6 * 3600,
+	"CST":  -6 * 3600,
+	"CDT":  -5 * 3600,
+	"EST":  -5 * 3600,
+	"EDT":  -4 * 3600,
+	"AST":  -4 * 3600,
+	"NST":  -3*3600 - 1800,
+}
+
+// DefaultZoneAbbreviations returns a copy of the abbreviation table used
+// by AllowZoneAbbreviations, mapping abbreviations to UTC offsets in
+// seconds.
+func DefaultZoneAbbreviations() map[string]int {
+	out := make(map[string]int, len(defaultZoneAbbreviations))
+	for k, v := range defaultZoneAbbreviations {
+		out[k] = v
+	}
+	return out
+}
+
+// AllowZoneAbbreviations accepts inputs ending in a time zone abbreviation
+// such as "2024-12-14T12:00:00 EST" instead of "Z". Entries in overrides,
+// which map abbreviations to UTC offsets in seconds, replace or extend the
+// defaults; use them to settle ambiguous abbreviations like "IST".
+// Unknown abbreviations are rejected with ErrUnknownZone.
+func AllowZoneAbbreviations(overrides map[string]int) ParseOption {
+	return func(c *parseConfig) {
+		table := DefaultZoneAbbreviations()
+		for k, v := range overrides {
+			table[strings.ToUpper(k)] = v
+		}
+		c.abbreviations = table
+	}
+}
+
+// zoneAbbreviationOffset looks up a trailing abbreviation in rest. It
+// reports matched=false when rest is not an alphabetic abbreviation.
+func zoneAbbreviationOffset(rest []byte, table map[string]int) (offset int, matched bool, err error) {
+	name := strings.TrimLeft(string(rest), " ")
+	if name == "" || name == "Z" || name == "z" {
+		return 0, false, nil
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i] | 0x20
+		if c < 'a' || c > 'z' {
+			return 0, false, nil
+		}
+	}
+
+	offset, ok := table[strings.ToUpper(name)]
+	if !ok {
+		return 0, true, ErrUnknownZone
+	}
+	return offset, true, nil
+}
@@ -0,0 +1,43 @@
+package universal_timestamp
+
+import "testing"
+
+func TestZoneAbbreviations(t *testing.T) {
+	cases := []struct {
+		input     string
+		overrides map[string]int
+		expected  string
+	}{
+		{"2024-12-14T12:00:00 EST", nil, "2024-12-14T17:00:00Z"},
+		{"2024-12-14T12:00:00CET", nil, "2024-12-14T11:00:00Z"},
+		{"2024-12-14T12:00:00.5 utc", nil, "2024-12-14T12:00:00.5Z"},
+		{"2024-12-14T12:00:00 IST", nil, "2024-12-14T06:30:00Z"},
+		{"2024-12-14T12:00:00 IST", map[string]int{"ist": 2 * 3600}, "2024-12-14T10:00:00Z"},
+		{"2024-12-14T12:00:00Z", nil, "2024-12-14T12:00:00Z"},
+	}
+	for _, c := range cases {
+		ts, err := Parse(c.input, AllowZoneAbbreviations(c.overrides))
+		if err != nil {
+			t.Errorf("Parse(%q) failed: %v", c.input, err)
+			continue
+		}
+		if got := ts.Format(); got != c.expected {
+			t.Errorf("Parse(%q) = %s, expected %s", c.input, got, c.expected)
+		}
+	}
+}
+
+func TestZoneAbbreviationsReject(t *testing.T) {
+	if _, err := Parse("2024-12-14T12:00:00 XYZT", AllowZoneAbbreviations(nil)); err != ErrUnknownZone {
+		t.Errorf("expected ErrUnknownZone, got %v", err)
+	}
+	if _, err := Parse("2024-12-14T12:00:00 EST"); err == nil {
+		t.Error("abbreviation accepted without AllowZoneAbbreviations")
+	}
+
+	table := DefaultZoneAbbreviations()
+	table["EST"] = 0
+	if defaultZoneAbbreviations["EST"] != -5*3600 {
+		t.Error("DefaultZoneAbbreviations() returned the shared table")
+	}
+}
@@ -0,0 +1,184 @@
+package universal_timestamp
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// zonedLayout renders a zoned timestamp with its numeric offset.
+const zonedLayout = "2006-01-02T15:04:05.999999999Z07:00"
+
+// ZonedTimestamp pairs an instant with the time zone it should be viewed
+// in, either an IANA zone such as "Europe/Paris" or a fixed offset.
+// Calendar arithmetic follows the zone's wall clock, so 9am Paris time
+// stays 9am across DST changes.
+type ZonedTimestamp struct {
+	Instant  Timestamp
+	Location *time.Location
+}
+
+// NewZoned returns ts viewed in loc. A nil loc is treated as UTC.
+func NewZoned(ts Timestamp, loc *time.Location) ZonedTimestamp {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return ZonedTimestamp{Instant: ts, Location: loc}
+}
+
+// ZonedFromTime returns the instant and location of t.
+func ZonedFromTime(t time.Time) ZonedTimestamp {
+	return ZonedTimestamp{Instant: FromTime(t), Location: t.Location()}
+}
+
+// ZonedDate returns the instant at the given wall-clock time in loc, as
+// time.Date does.
+func ZonedDate(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) ZonedTimestamp {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return ZonedFromTime(time.Date(year, month, day, hour, min, sec, nsec, loc))
+}
+
+// ParseZoned parses an RFC 3339 timestamp with a numeric offset or "Z",
+// optionally followed by an IANA zone name in brackets as written by
+// Format, such as "2024-12-14T13:00:00+01:00[Europe/Paris]". Without a
+// bracketed name the result uses a fixed offset, or UTC for "Z". The
+// instant is always taken from the numeric offset.
+func ParseZoned(s string) (ZonedTimestamp, error) {
+	var loc *time.Location
+	if i := strings.IndexByte(s, '['); i >= 0 {
+		if !strings.HasSuffix(s, "]") {
+			return ZonedTimestamp{}, ErrInvalidFormat
+		}
+		var err error
+		if loc, err = time.LoadLocation(s[i+1 : len(s)-1]); err != nil {
+			return ZonedTimestamp{}, fmt.Errorf("%w: %s", ErrUnknownZone, s[i+1:len(s)-1])
+		}
+		s = s[:i]
+	}
+
+	ts, offset, err := ParseWithOffset(s)
+	if err != nil {
+		return ZonedTimestamp{}, err
+	}
+	if loc == nil {
+		loc = offsetZone(offset)
+	}
+	return ZonedTimestamp{Instant: ts, Location: loc}, nil
+}
+
+// offsetZone returns the location for a bare UTC offset in seconds: UTC
+// for zero and an unnamed fixed zone otherwise.
+func offsetZone(offset int) *time.Location {
+	if offset == 0 {
+		return time.UTC
+	}
+	return time.FixedZone("", offset)
+}
+
+// Time returns the zoned timestamp as a time.Time in its location.
+func (z ZonedTimestamp) Time() time.Time {
+	return z.Instant.In(z.Location)
+}
+
+// Offset returns the zone's offset from UTC, in seconds, at the instant.
+func (z ZonedTimestamp) Offset() int {
+	_, offset := z.Time().Zone()
+	return offset
+}
+
+// In returns the same instant viewed in loc.
+func (z ZonedTimestamp) In(loc *time.Location) ZonedTimestamp {
+	return NewZoned(z.Instant, loc)
+}
+
+// AddDate adds years, months and days to the wall-clock date, keeping the
+// local time of day, and normalizes the result as time.Time.AddDate does.
+func (z ZonedTimestamp) AddDate(years, months, days int) ZonedTimestamp {
+	return ZonedFromTime(z.Time().AddDate(years, months, days))
+}
+
+// Format renders the zoned timestamp in RFC 3339 form with its local
+// offset, followed by the zone name in brackets for named zones other
+// than UTC, such as "2024-12-14T13:00:00+01:00[Europe/Paris]".
+func (z ZonedTimestamp) Format() string {
+	s := z.Time().Format(zonedLayout)
+	if name := z.zoneName(); name != "" {
+		s += "[" + name + "]"
+	}
+	return s
+}
+
+// String implements fmt.Stringer using Format.
+func (z ZonedTimestamp) String() string {
+	return z.Format()
+}
+
+// zoneName returns the IANA name to record in brackets, or "" for UTC and
+// fixed offsets.
+func (z ZonedTimestamp) zoneName() string {
+	if z.Location == nil {
+		return ""
+	}
+	switch name := z.Location.String(); name {
+	case "", "UTC", "Local":
+		return ""
+	default:
+		return name
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler using Format.
+func (z ZonedTimestamp) MarshalText() ([]byte, error) {
+	return []byte(z.Format()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseZoned.
+func (z *ZonedTimestamp) UnmarshalText(text []byte) error {
+	parsed, err := ParseZoned(string(text))
+	if err != nil {
+		return err
+	}
+	*z = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the Format string.
+func (z ZonedTimestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(z.Format())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a Format string.
+func (z *ZonedTimestamp) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return z.UnmarshalText([]byte(s))
+}
+
+// Value implements driver.Valuer. The value is a time.Time in the zone,
+// which timestamptz columns store as an instant; use a text column and
+// Format to also keep the zone name.
+func (z ZonedTimestamp) Value() (driver.Value, error) {
+	return z.Time(), nil
+}
+
+// Scan implements sql.Scanner for time.Time values and for strings in the
+// form written by Format.
+func (z *ZonedTimestamp) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case time.Time:
+		*z = ZonedFromTime(v)
+		return nil
+	case string:
+		return z.UnmarshalText([]byte(v))
+	case []byte:
+		return z.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into ZonedTimestamp", src)
+	}
+}
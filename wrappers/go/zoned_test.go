@@ -0,0 +1,84 @@
+package universal_timestamp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestZonedFormatParse(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	z := NewZoned(mustParse(t, "2024-12-14T12:00:00Z"), loc)
+	s := z.Format()
+	if s != "2024-12-14T13:00:00+01:00[Europe/Paris]" {
+		t.Errorf("Format() = %s", s)
+	}
+
+	back, err := ParseZoned(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.Instant != z.Instant || back.Location.String() != "Europe/Paris" {
+		t.Errorf("ParseZoned() = %v", back)
+	}
+
+	fixed, err := ParseZoned("2024-12-14T12:00:00+05:30")
+	if err != nil || fixed.Offset() != 19800 || fixed.Format() != "2024-12-14T12:00:00+05:30" {
+		t.Errorf("ParseZoned(fixed) = %v, %v", fixed, err)
+	}
+
+	utc, err := ParseZoned("2024-12-14T12:00:00Z")
+	if err != nil || utc.Format() != "2024-12-14T12:00:00Z" {
+		t.Errorf("ParseZoned(Z) = %v, %v", utc, err)
+	}
+
+	if _, err := ParseZoned("2024-12-14T12:00:00Z[Mars/Olympus]"); err == nil {
+		t.Error("unknown zone accepted")
+	}
+}
+
+func TestZonedAddDateAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	z := ZonedDate(2024, time.March, 30, 9, 0, 0, 0, loc)
+	next := z.AddDate(0, 0, 1)
+	if got := next.Format(); got != "2024-03-31T09:00:00+02:00[Europe/Paris]" {
+		t.Errorf("AddDate across DST = %s", got)
+	}
+	if Duration(next.Instant-z.Instant) != 23*Hour {
+		t.Errorf("expected a 23 hour day, got %s", Duration(next.Instant-z.Instant))
+	}
+}
+
+func TestZonedJSONSQL(t *testing.T) {
+	z := NewZoned(mustParse(t, "2024-12-14T12:00:00Z"), time.FixedZone("", -3600))
+
+	data, err := json.Marshal(z)
+	if err != nil || string(data) != `"2024-12-14T11:00:00-01:00"` {
+		t.Errorf("MarshalJSON() = %s, %v", data, err)
+	}
+
+	var back ZonedTimestamp
+	if err := json.Unmarshal(data, &back); err != nil || back.Instant != z.Instant {
+		t.Errorf("UnmarshalJSON() = %v, %v", back, err)
+	}
+
+	v, err := z.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scanned ZonedTimestamp
+	if err := scanned.Scan(v); err != nil || scanned.Instant != z.Instant || scanned.Offset() != -3600 {
+		t.Errorf("Scan(Value()) = %v, %v", scanned, err)
+	}
+	if err := scanned.Scan(42); err == nil {
+		t.Error("Scan(int) succeeded")
+	}
+}
@@ -93,6 +93,7 @@ class Error(IntEnum):
     FRACTION_TOO_LONG = 5
     LEAP_SECOND = 6
     NULL_POINTER = 7
+    UNSUPPORTED_CLOCK = 8
 
 
 class Precision(IntEnum):